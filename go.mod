@@ -0,0 +1,3 @@
+module github.com/kdeconinck/seesharp
+
+go 1.22
@@ -0,0 +1,164 @@
+// =====================================================================================================================
+// == LICENSE:       Copyright (c) 2024 Kevin De Coninck
+// ==
+// ==                Permission is hereby granted, free of charge, to any person
+// ==                obtaining a copy of this software and associated documentation
+// ==                files (the "Software"), to deal in the Software without
+// ==                restriction, including without limitation the rights to use,
+// ==                copy, modify, merge, publish, distribute, sublicense, and/or sell
+// ==                copies of the Software, and to permit persons to whom the
+// ==                Software is furnished to do so, subject to the following
+// ==                conditions:
+// ==
+// ==                The above copyright notice and this permission notice shall be
+// ==                included in all copies or substantial portions of the Software.
+// ==
+// ==                THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// ==                EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// ==                OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// ==                NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// ==                HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// ==                WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// ==                FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+// ==                OTHER DEALINGS IN THE SOFTWARE.
+// =====================================================================================================================
+
+package assert
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+// The number of bytes shown on either side of a match in a haystack excerpt.
+const excerptContext = 20
+
+// The maximum number of elements shown when printing the contents of a slice or the keys of a map.
+const maxListed = 10
+
+// Contains asserts that s contains substr.
+// On failure, the part of s around the longest prefix of substr that s does contain is shown.
+func Contains(tb testing.TB, s, substr string) {
+	tb.Helper()
+
+	if strings.Contains(s, substr) {
+		return
+	}
+
+	for n := len(substr) - 1; n > 0; n-- {
+		if !utf8.RuneStart(substr[n]) {
+			continue
+		}
+
+		if idx := strings.Index(s, substr[:n]); idx >= 0 {
+			tb.Errorf("Contains: %q not found, nearest match %q in %s", substr, substr[:n], excerpt(s, idx, idx+n))
+
+			return
+		}
+	}
+
+	tb.Errorf("Contains: %q not found in %s", substr, excerpt(s, 0, 0))
+}
+
+// NotContains asserts that s doesn't contain substr.
+// On failure, the part of s around the first occurrence of substr is shown.
+func NotContains(tb testing.TB, s, substr string) {
+	tb.Helper()
+
+	if idx := strings.Index(s, substr); idx >= 0 {
+		tb.Errorf("NotContains: %q found in %s", substr, excerpt(s, idx, idx+len(substr)))
+	}
+}
+
+// ContainsElement asserts that slice contains v.
+func ContainsElement[T comparable](tb testing.TB, slice []T, v T) {
+	tb.Helper()
+
+	if opts := optionsFor(tb); !slices.Contains(slice, v) {
+		tb.Errorf("ContainsElement: %s not found in %s", formatGoValue(opts, v), listed(opts, slice))
+	}
+}
+
+// NotContainsElement asserts that slice doesn't contain v.
+func NotContainsElement[T comparable](tb testing.TB, slice []T, v T) {
+	tb.Helper()
+
+	if idx := slices.Index(slice, v); idx >= 0 {
+		opts := optionsFor(tb)
+		tb.Errorf("NotContainsElement: %s found at index %d of %s", formatGoValue(opts, v), idx, listed(opts, slice))
+	}
+}
+
+// ContainsKey asserts that m contains key k.
+func ContainsKey[K comparable, V any](tb testing.TB, m map[K]V, k K) {
+	tb.Helper()
+
+	if _, ok := m[k]; !ok {
+		opts := optionsFor(tb)
+		tb.Errorf("ContainsKey: %s not found in keys %s", formatGoValue(opts, k), listed(opts, sortedMapKeys(m)))
+	}
+}
+
+// NotContainsKey asserts that m doesn't contain key k.
+func NotContainsKey[K comparable, V any](tb testing.TB, m map[K]V, k K) {
+	tb.Helper()
+
+	if v, ok := m[k]; ok {
+		opts := optionsFor(tb)
+		tb.Errorf("NotContainsKey: %s found with value %s", formatGoValue(opts, k), formatGoValue(opts, v))
+	}
+}
+
+// Returns the part of s from start to end, together with up to excerptContext bytes on either side.
+func excerpt(s string, start, end int) string {
+	from, to := max(start-excerptContext, 0), min(end+excerptContext, len(s))
+
+	for from > 0 && !utf8.RuneStart(s[from]) {
+		from--
+	}
+
+	for to < len(s) && !utf8.RuneStart(s[to]) {
+		to++
+	}
+
+	var sb strings.Builder
+
+	if from > 0 {
+		sb.WriteString("…")
+	}
+
+	fmt.Fprintf(&sb, "%q", s[from:to])
+
+	if to < len(s) {
+		sb.WriteString("…")
+	}
+
+	return sb.String()
+}
+
+// Returns the printable form of the first maxListed elements of slice, formatted according to opts.
+func listed[T any](opts Options, slice []T) string {
+	if len(slice) <= maxListed {
+		return formatGoValue(opts, slice)
+	}
+
+	return fmt.Sprintf("%s (and %d more)", formatGoValue(opts, slice[:maxListed]), len(slice)-maxListed)
+}
+
+// Returns the keys of m, sorted by their printable form so that failure messages are deterministic.
+func sortedMapKeys[K comparable, V any](m map[K]V) []K {
+	keys := make([]K, 0, len(m))
+
+	for k := range m {
+		keys = append(keys, k)
+	}
+
+	slices.SortFunc(keys, func(a, b K) int {
+		return strings.Compare(fmt.Sprint(a), fmt.Sprint(b))
+	})
+
+	return keys
+}
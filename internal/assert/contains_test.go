@@ -0,0 +1,125 @@
+// =====================================================================================================================
+// == LICENSE:       Copyright (c) 2024 Kevin De Coninck
+// ==
+// ==                Permission is hereby granted, free of charge, to any person
+// ==                obtaining a copy of this software and associated documentation
+// ==                files (the "Software"), to deal in the Software without
+// ==                restriction, including without limitation the rights to use,
+// ==                copy, modify, merge, publish, distribute, sublicense, and/or sell
+// ==                copies of the Software, and to permit persons to whom the
+// ==                Software is furnished to do so, subject to the following
+// ==                conditions:
+// ==
+// ==                The above copyright notice and this permission notice shall be
+// ==                included in all copies or substantial portions of the Software.
+// ==
+// ==                THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// ==                EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// ==                OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// ==                NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// ==                HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// ==                WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// ==                FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+// ==                OTHER DEALINGS IN THE SOFTWARE.
+// =====================================================================================================================
+
+package assert_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/kdeconinck/seesharp/internal/assert"
+)
+
+func TestContainsPasses(t *testing.T) {
+	t.Parallel()
+
+	r := newRecorder(t)
+
+	assert.Contains(r, "hello world", "o w")
+	assert.Contains(r, "anything", "")
+	assert.NotContains(r, "hello", "x")
+	assert.ContainsElement(r, []int{1, 2}, 2)
+	assert.NotContainsElement(r, []string{"a"}, "b")
+	assert.NotContainsElement(r, nil, "b")
+	assert.ContainsKey(r, map[string]int{"a": 1}, "a")
+	assert.NotContainsKey(r, map[int]bool{}, 3)
+	checkFailures(t, r)
+}
+
+func TestContainsFailures(t *testing.T) {
+	t.Parallel()
+
+	long := strings.Repeat("x", 30)
+
+	for _, tc := range []struct {
+		name    string
+		assert  func(tb *recorder)
+		failure string
+	}{
+		{
+			name:    "SubstringNearestMatch",
+			assert:  func(tb *recorder) { assert.Contains(tb, "The quick brown fox jumps over the lazy dog", "lazy cat") },
+			failure: `Contains: "lazy cat" not found, nearest match "lazy " in …" fox jumps over the lazy dog"`,
+		},
+		{
+			name:    "SubstringNoMatch",
+			assert:  func(tb *recorder) { assert.Contains(tb, long+"y", "abc") },
+			failure: `Contains: "abc" not found in "xxxxxxxxxxxxxxxxxxxx"…`,
+		},
+		{
+			name:    "SubstringMultiByte",
+			assert:  func(tb *recorder) { assert.Contains(tb, "ééééééééééééé test ééé", "test case") },
+			failure: `Contains: "test case" not found, nearest match "test " in …"éééééééééé test ééé"`,
+		},
+		{
+			name:    "NotContains",
+			assert:  func(tb *recorder) { assert.NotContains(tb, long+"needle"+long, "needle") },
+			failure: `NotContains: "needle" found in …"xxxxxxxxxxxxxxxxxxxxneedlexxxxxxxxxxxxxxxxxxxx"…`,
+		},
+		{
+			name:    "Element",
+			assert:  func(tb *recorder) { assert.ContainsElement(tb, []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12}, 99) },
+			failure: "ContainsElement: 99 not found in []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10} (and 2 more)",
+		},
+		{
+			name:    "NotElement",
+			assert:  func(tb *recorder) { assert.NotContainsElement(tb, []string{"a", "b"}, "b") },
+			failure: `NotContainsElement: "b" found at index 1 of []string{"a", "b"}`,
+		},
+		{
+			name:    "Key",
+			assert:  func(tb *recorder) { assert.ContainsKey(tb, map[string]int{"b": 1, "a": 2}, "c") },
+			failure: `ContainsKey: "c" not found in keys []string{"a", "b"}`,
+		},
+		{
+			name:    "NotKey",
+			assert:  func(tb *recorder) { assert.NotContainsKey(tb, map[string][]int{"b": {1}}, "b") },
+			failure: `NotContainsKey: "b" found with value []int{1}`,
+		},
+		{
+			name: "TruncatedValues",
+			assert: func(tb *recorder) {
+				assert.SetOptions(tb, assert.Options{MaxWidth: 12})
+				assert.NotContainsKey(tb, map[string]string{long: long}, long)
+			},
+			failure: `NotContainsKey: "xxxxxxxxxxx… found with value "xxxxxxxxxxx…`,
+		},
+		{
+			name: "TruncatedElements",
+			assert: func(tb *recorder) {
+				assert.SetOptions(tb, assert.Options{MaxWidth: 12})
+				assert.ContainsElement(tb, []string{long}, "y")
+			},
+			failure: `ContainsElement: "y" not found in []string{"xx…`,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			r := newRecorder(t)
+
+			tc.assert(r)
+			checkFailures(t, r, tc.failure)
+		})
+	}
+}
@@ -0,0 +1,161 @@
+// =====================================================================================================================
+// == LICENSE:       Copyright (c) 2024 Kevin De Coninck
+// ==
+// ==                Permission is hereby granted, free of charge, to any person
+// ==                obtaining a copy of this software and associated documentation
+// ==                files (the "Software"), to deal in the Software without
+// ==                restriction, including without limitation the rights to use,
+// ==                copy, modify, merge, publish, distribute, sublicense, and/or sell
+// ==                copies of the Software, and to permit persons to whom the
+// ==                Software is furnished to do so, subject to the following
+// ==                conditions:
+// ==
+// ==                The above copyright notice and this permission notice shall be
+// ==                included in all copies or substantial portions of the Software.
+// ==
+// ==                THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// ==                EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// ==                OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// ==                NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// ==                HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// ==                WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// ==                FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+// ==                OTHER DEALINGS IN THE SOFTWARE.
+// =====================================================================================================================
+
+package assert
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+	"unicode/utf8"
+)
+
+// ANSI escape sequences used to mark the first difference between got and want values.
+const (
+	colorGot   = "\x1b[31m"
+	colorWant  = "\x1b[32m"
+	colorReset = "\x1b[0m"
+)
+
+// Options controls how got and want values are printed in failure messages.
+// The zero value prints values in full and without colors.
+type Options struct {
+	// MaxWidth is the maximum number of bytes printed for a single value. Longer values are truncated around the first
+	// difference between got and want, keeping a quarter of MaxWidth as context before it. A MaxWidth of 0 disables
+	// truncation.
+	MaxWidth int
+
+	// Color marks the part of got and want starting at their first difference with ANSI colors.
+	Color bool
+}
+
+// The options of each test that called SetOptions, keyed by its testing.TB.
+var options sync.Map
+
+// SetOptions sets the options used by the assertions that are passed tb, until tb's test and all its subtests have
+// completed. Subtests don't inherit the options of their parent.
+func SetOptions(tb testing.TB, opts Options) {
+	tb.Helper()
+
+	options.Store(tb, opts)
+	tb.Cleanup(func() { options.Delete(tb) })
+}
+
+// Returns the options set for tb.
+func optionsFor(tb testing.TB) Options {
+	if opts, ok := options.Load(tb); ok {
+		return opts.(Options)
+	}
+
+	return Options{}
+}
+
+// Returns the printable forms got and want formatted according to opts.
+func formatPair(opts Options, got, want string) (string, string) {
+	truncate := opts.MaxWidth > 0 && (len(got) > opts.MaxWidth || len(want) > opts.MaxWidth)
+
+	if !opts.Color && !truncate {
+		return got, want
+	}
+
+	diff := 0
+
+	for diff < len(got) && diff < len(want) && got[diff] == want[diff] {
+		diff++
+	}
+
+	for diff > 0 && (diff < len(got) && !utf8.RuneStart(got[diff]) || diff < len(want) && !utf8.RuneStart(want[diff])) {
+		diff--
+	}
+
+	// Both values are identical before diff, so the same start is valid for both of them.
+	start := 0
+
+	if truncate && diff > opts.MaxWidth/4 {
+		start = diff - opts.MaxWidth/4
+
+		// When MaxWidth is below 4, start equals diff, which may be the end of got.
+		for start > 0 && start < len(got) && !utf8.RuneStart(got[start]) {
+			start--
+		}
+	}
+
+	return formatFrom(opts, got, start, diff, colorGot), formatFrom(opts, want, start, diff, colorWant)
+}
+
+// Returns at most opts.MaxWidth bytes of v from start on, with the part from diff on colored with color when
+// opts.Color is set. Omitted parts are marked with an ellipsis.
+func formatFrom(opts Options, v string, start, diff int, color string) string {
+	end := len(v)
+
+	if opts.MaxWidth > 0 && end-start > opts.MaxWidth {
+		end = start + opts.MaxWidth
+
+		for end > diff && !utf8.RuneStart(v[end]) {
+			end--
+		}
+	}
+
+	var sb strings.Builder
+
+	if start > 0 {
+		sb.WriteString("…")
+	}
+
+	sb.WriteString(v[start:diff])
+
+	if opts.Color && diff < end {
+		sb.WriteString(color + v[diff:end] + colorReset)
+	} else {
+		sb.WriteString(v[diff:end])
+	}
+
+	if end < len(v) {
+		sb.WriteString("…")
+	}
+
+	return sb.String()
+}
+
+// Returns the printable form v truncated to opts.MaxWidth bytes.
+func formatValue(opts Options, v string) string {
+	if opts.MaxWidth <= 0 || len(v) <= opts.MaxWidth {
+		return v
+	}
+
+	end := opts.MaxWidth
+
+	for end > 0 && !utf8.RuneStart(v[end]) {
+		end--
+	}
+
+	return v[:end] + "…"
+}
+
+// Returns the Go-syntax representation of v truncated to opts.MaxWidth bytes.
+func formatGoValue(opts Options, v any) string {
+	return formatValue(opts, fmt.Sprintf("%#v", v))
+}
@@ -0,0 +1,162 @@
+// =====================================================================================================================
+// == LICENSE:       Copyright (c) 2024 Kevin De Coninck
+// ==
+// ==                Permission is hereby granted, free of charge, to any person
+// ==                obtaining a copy of this software and associated documentation
+// ==                files (the "Software"), to deal in the Software without
+// ==                restriction, including without limitation the rights to use,
+// ==                copy, modify, merge, publish, distribute, sublicense, and/or sell
+// ==                copies of the Software, and to permit persons to whom the
+// ==                Software is furnished to do so, subject to the following
+// ==                conditions:
+// ==
+// ==                The above copyright notice and this permission notice shall be
+// ==                included in all copies or substantial portions of the Software.
+// ==
+// ==                THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// ==                EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// ==                OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// ==                NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// ==                HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// ==                WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// ==                FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+// ==                OTHER DEALINGS IN THE SOFTWARE.
+// =====================================================================================================================
+
+package assert
+
+import (
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+func TestFormatPair(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name            string
+		opts            Options
+		got, want       string
+		wantGot, wantWt string
+	}{
+		{name: "Disabled", got: "abcdef", want: "abcxef", wantGot: "abcdef", wantWt: "abcxef"},
+		{
+			name: "ShortValues", opts: Options{MaxWidth: 10},
+			got: "abcdef", want: "abcxef", wantGot: "abcdef", wantWt: "abcxef",
+		},
+		{
+			name: "Color", opts: Options{Color: true},
+			got: "abcd", want: "abxd", wantGot: "ab\x1b[31mcd\x1b[0m", wantWt: "ab\x1b[32mxd\x1b[0m",
+		},
+		{
+			name: "Prefix", opts: Options{Color: true},
+			got: "ab", want: "abcd", wantGot: "ab", wantWt: "ab\x1b[32mcd\x1b[0m",
+		},
+		{
+			name: "TruncateAroundDifference", opts: Options{MaxWidth: 8},
+			got: "0123456789X0123456789", want: "0123456789Y0123456789",
+			wantGot: "…89X01234…", wantWt: "…89Y01234…",
+		},
+		{
+			name: "TruncateMultiByte", opts: Options{MaxWidth: 8},
+			got: "ééééé1ééééé", want: "ééééé2ééééé",
+			wantGot: "…é1éé…", wantWt: "…é2éé…",
+		},
+		{
+			name: "DifferenceInsideRune", opts: Options{MaxWidth: 4},
+			got: "aaaaé", want: "aaaaè", wantGot: "…aé", wantWt: "…aè",
+		},
+	} {
+		gotS, wantS := formatPair(tc.opts, tc.got, tc.want)
+
+		if gotS != tc.wantGot || wantS != tc.wantWt {
+			t.Errorf("%s: formatPair(%+v, %q, %q) = %q, %q, want %q, %q", tc.name, tc.opts, tc.got, tc.want, gotS,
+				wantS, tc.wantGot, tc.wantWt)
+		}
+	}
+}
+
+// Verifies the output of formatPair for small widths and multi-byte runes on either side of the difference: it must
+// not panic, must contain valid UTF-8, must stay within MaxWidth and must be a part of the original value.
+func TestFormatPairSmallWidths(t *testing.T) {
+	t.Parallel()
+
+	prefixes := []string{"", "a", "é", "日本", "a😀", "😀😀é", "abcdefgh", "日本語テキスト"}
+	suffixes := []string{"", "b", "é", "語", "😀x", "xyz😀", "ééééé", "本日本日本日"}
+
+	for maxWidth := 1; maxWidth <= 7; maxWidth++ {
+		for _, color := range []bool{false, true} {
+			opts := Options{MaxWidth: maxWidth, Color: color}
+
+			for _, prefix := range prefixes {
+				for _, gotSuffix := range suffixes {
+					for _, wantSuffix := range suffixes {
+						got, want := prefix+gotSuffix, prefix+wantSuffix
+						gotS, wantS := formatPair(opts, got, want)
+
+						checkFormatted(t, opts, got, gotS)
+						checkFormatted(t, opts, want, wantS)
+					}
+				}
+			}
+		}
+	}
+}
+
+// Checks that formatted is a valid rendering of v according to opts.
+func checkFormatted(t *testing.T, opts Options, v, formatted string) {
+	t.Helper()
+
+	inner := strings.NewReplacer(colorGot, "", colorWant, "", colorReset, "").Replace(formatted)
+	truncatedStart, truncatedEnd := strings.HasPrefix(inner, "…"), strings.HasSuffix(inner, "…")
+	inner = strings.TrimSuffix(strings.TrimPrefix(inner, "…"), "…")
+
+	switch {
+	case !utf8.ValidString(inner):
+		t.Errorf("%+v: %q rendered as %q, which isn't valid UTF-8", opts, v, formatted)
+	case !strings.Contains(v, inner):
+		t.Errorf("%+v: %q rendered as %q, which isn't a part of it", opts, v, formatted)
+	case len(v) > opts.MaxWidth && len(inner) > opts.MaxWidth:
+		t.Errorf("%+v: %q rendered as %q, which is wider than %d bytes", opts, v, formatted, opts.MaxWidth)
+	case !truncatedStart && !truncatedEnd && inner != v:
+		t.Errorf("%+v: %q rendered as %q without marking the truncation", opts, v, formatted)
+	}
+}
+
+func TestFormatValue(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		maxWidth int
+		input    string
+		want     string
+	}{
+		{maxWidth: 0, input: "abcdef", want: "abcdef"},
+		{maxWidth: 6, input: "abcdef", want: "abcdef"},
+		{maxWidth: 3, input: "abcdef", want: "abc…"},
+		{maxWidth: 3, input: "aéb", want: "aé…"},
+		{maxWidth: 2, input: "aéb", want: "a…"},
+		{maxWidth: 1, input: "éé", want: "…"},
+	} {
+		if got := formatValue(Options{MaxWidth: tc.maxWidth}, tc.input); got != tc.want {
+			t.Errorf("formatValue(%d, %q) = %q, want %q", tc.maxWidth, tc.input, got, tc.want)
+		}
+	}
+}
+
+func TestSetOptions(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Scoped", func(t *testing.T) {
+		SetOptions(t, Options{MaxWidth: 3})
+
+		if got := optionsFor(t); got.MaxWidth != 3 {
+			t.Errorf("optionsFor = %+v, want the options that were set", got)
+		}
+	})
+
+	if got := optionsFor(t); got != (Options{}) {
+		t.Errorf("optionsFor = %+v on another test, want the zero value", got)
+	}
+}
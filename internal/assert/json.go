@@ -0,0 +1,197 @@
+// =====================================================================================================================
+// == LICENSE:       Copyright (c) 2024 Kevin De Coninck
+// ==
+// ==                Permission is hereby granted, free of charge, to any person
+// ==                obtaining a copy of this software and associated documentation
+// ==                files (the "Software"), to deal in the Software without
+// ==                restriction, including without limitation the rights to use,
+// ==                copy, modify, merge, publish, distribute, sublicense, and/or sell
+// ==                copies of the Software, and to permit persons to whom the
+// ==                Software is furnished to do so, subject to the following
+// ==                conditions:
+// ==
+// ==                The above copyright notice and this permission notice shall be
+// ==                included in all copies or substantial portions of the Software.
+// ==
+// ==                THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// ==                EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// ==                OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// ==                NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// ==                HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// ==                WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// ==                FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+// ==                OTHER DEALINGS IN THE SOFTWARE.
+// =====================================================================================================================
+
+package assert
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"slices"
+	"strconv"
+	"testing"
+)
+
+// JSONEqual asserts that got and want are semantically equal JSON documents.
+// Object key order and insignificant whitespace are ignored. Every difference is reported with the path to the
+// element in which it occurs, such as "$.assemblies[0].name".
+func JSONEqual(tb testing.TB, got, want string) {
+	tb.Helper()
+
+	gotV, err := decodeJSON(got)
+
+	if err != nil {
+		tb.Errorf("JSONEqual: got is not valid JSON: %v", err)
+
+		return
+	}
+
+	wantV, err := decodeJSON(want)
+
+	if err != nil {
+		tb.Errorf("JSONEqual: want is not valid JSON: %v", err)
+
+		return
+	}
+
+	if diffs := diffJSON(optionsFor(tb), "$", gotV, wantV, nil); len(diffs) > 0 {
+		failDiff(tb, "JSONEqual", diffs)
+	}
+}
+
+// Decodes v, which must contain exactly one JSON value. Numbers are decoded as json.Number to compare them exactly.
+func decodeJSON(v string) (any, error) {
+	dec := json.NewDecoder(bytes.NewReader([]byte(v)))
+	dec.UseNumber()
+
+	var res any
+
+	if err := dec.Decode(&res); err != nil {
+		return nil, err
+	}
+
+	if _, err := dec.Token(); err != io.EOF {
+		return nil, fmt.Errorf("unexpected data after the top-level value")
+	}
+
+	return res, nil
+}
+
+// Appends a description of each difference between got and want, which are located at path, to diffs.
+func diffJSON(opts Options, path string, got, want any, diffs []string) []string {
+	switch wantV := want.(type) {
+	case map[string]any:
+		gotV, ok := got.(map[string]any)
+
+		if !ok {
+			break
+		}
+
+		for _, k := range sortedKeys(wantV) {
+			if _, ok := gotV[k]; !ok {
+				diffs = append(diffs, fmt.Sprintf("%s: missing, want %s", jsonPath(path, k),
+					formatValue(opts, formatJSON(wantV[k]))))
+			}
+		}
+
+		for _, k := range sortedKeys(gotV) {
+			if _, ok := wantV[k]; !ok {
+				diffs = append(diffs, fmt.Sprintf("%s: unexpected, got %s", jsonPath(path, k),
+					formatValue(opts, formatJSON(gotV[k]))))
+			} else {
+				diffs = diffJSON(opts, jsonPath(path, k), gotV[k], wantV[k], diffs)
+			}
+		}
+
+		return diffs
+
+	case []any:
+		gotV, ok := got.([]any)
+
+		if !ok {
+			break
+		}
+
+		if len(gotV) != len(wantV) {
+			diffs = append(diffs, fmt.Sprintf("%s: got %d element(s), want %d", path, len(gotV), len(wantV)))
+		}
+
+		for idx := range min(len(gotV), len(wantV)) {
+			diffs = diffJSON(opts, path+"["+strconv.Itoa(idx)+"]", gotV[idx], wantV[idx], diffs)
+		}
+
+		return diffs
+
+	case json.Number:
+		if gotV, ok := got.(json.Number); ok && numbersEqual(gotV, wantV) {
+			return diffs
+		}
+
+	default:
+		if got == want {
+			return diffs
+		}
+	}
+
+	gotS, wantS := formatPair(opts, formatJSON(got), formatJSON(want))
+
+	return append(diffs, fmt.Sprintf("%s: got %s, want %s", path, gotS, wantS))
+}
+
+// Reports whether a and b denote the same number, so that "1", "1.0" and "1e0" are equal.
+func numbersEqual(a, b json.Number) bool {
+	var aR, bR big.Rat
+
+	if _, ok := aR.SetString(a.String()); !ok {
+		return a == b
+	}
+
+	if _, ok := bR.SetString(b.String()); !ok {
+		return a == b
+	}
+
+	return aR.Cmp(&bR) == 0
+}
+
+// Returns the path of key k in the object located at path.
+func jsonPath(path, k string) string {
+	if k == "" {
+		return path + `[""]`
+	}
+
+	for _, r := range k {
+		if !(r == '_' || r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9') {
+			return path + "[" + strconv.Quote(k) + "]"
+		}
+	}
+
+	return path + "." + k
+}
+
+// Returns the keys of m in ascending order.
+func sortedKeys(m map[string]any) []string {
+	keys := make([]string, 0, len(m))
+
+	for k := range m {
+		keys = append(keys, k)
+	}
+
+	slices.Sort(keys)
+
+	return keys
+}
+
+// Returns the compact JSON representation of v.
+func formatJSON(v any) string {
+	data, err := json.Marshal(v)
+
+	if err != nil {
+		return fmt.Sprint(v)
+	}
+
+	return string(data)
+}
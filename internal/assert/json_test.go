@@ -0,0 +1,108 @@
+// =====================================================================================================================
+// == LICENSE:       Copyright (c) 2024 Kevin De Coninck
+// ==
+// ==                Permission is hereby granted, free of charge, to any person
+// ==                obtaining a copy of this software and associated documentation
+// ==                files (the "Software"), to deal in the Software without
+// ==                restriction, including without limitation the rights to use,
+// ==                copy, modify, merge, publish, distribute, sublicense, and/or sell
+// ==                copies of the Software, and to permit persons to whom the
+// ==                Software is furnished to do so, subject to the following
+// ==                conditions:
+// ==
+// ==                The above copyright notice and this permission notice shall be
+// ==                included in all copies or substantial portions of the Software.
+// ==
+// ==                THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// ==                EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// ==                OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// ==                NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// ==                HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// ==                WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// ==                FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+// ==                OTHER DEALINGS IN THE SOFTWARE.
+// =====================================================================================================================
+
+package assert_test
+
+import (
+	"testing"
+
+	"github.com/kdeconinck/seesharp/internal/assert"
+)
+
+func TestJSONEqual(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name      string
+		got, want string
+	}{
+		{name: "Identical", got: `{"a":1}`, want: `{"a":1}`},
+		{name: "KeyOrderAndWhitespace", got: `{"b": [1, 2], "a": {"x": null}}`, want: "{\n\t\"a\":{\"x\":null},\"b\":[1,2]}"},
+		{name: "NumbersByValue", got: `{"a":1.0,"b":1e2,"c":-0,"d":0.10}`, want: `{"a":1,"b":100,"c":0,"d":1e-1}`},
+		{name: "LargeNumbers", got: `[12345678901234567890123]`, want: `[1.2345678901234567890123e22]`},
+		{name: "Scalars", got: `"text"`, want: ` "text" `},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			r := newRecorder(t)
+
+			assert.JSONEqual(r, tc.got, tc.want)
+			checkFailures(t, r)
+		})
+	}
+}
+
+func TestJSONEqualFailures(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name      string
+		got, want string
+		failure   string
+	}{
+		{
+			name: "Value",
+			got:  `{"a":{"b":[1,2]}}`, want: `{"a":{"b":[1,3]}}`,
+			failure: "JSONEqual: 1 difference(s):\n  $.a.b[1]: got 2, want 3",
+		},
+		{
+			name: "Numbers",
+			got:  `[1.5]`, want: `[1.50000001]`,
+			failure: "JSONEqual: 1 difference(s):\n  $[0]: got 1.5, want 1.50000001",
+		},
+		{
+			name: "NumberAndString",
+			got:  `{"a":1}`, want: `{"a":"1"}`,
+			failure: "JSONEqual: 1 difference(s):\n  $.a: got 1, want \"1\"",
+		},
+		{
+			name: "Keys",
+			got:  `{"a":1,"":true}`, want: `{"a":1,"b c":null}`,
+			failure: "JSONEqual: 2 difference(s):\n  $[\"b c\"]: missing, want null\n  $[\"\"]: unexpected, got true",
+		},
+		{
+			name: "Length",
+			got:  `[1,2,3]`, want: `[1,4]`,
+			failure: "JSONEqual: 2 difference(s):\n  $: got 3 element(s), want 2\n  $[1]: got 2, want 4",
+		},
+		{
+			name: "Type",
+			got:  `{"a":[]}`, want: `{"a":{}}`,
+			failure: "JSONEqual: 1 difference(s):\n  $.a: got [], want {}",
+		},
+		{name: "InvalidGot", got: `{`, want: `{}`, failure: "JSONEqual: got is not valid JSON: unexpected EOF"},
+		{
+			name: "TrailingData",
+			got:  `{}`, want: `{} []`,
+			failure: "JSONEqual: want is not valid JSON: unexpected data after the top-level value",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			r := newRecorder(t)
+
+			assert.JSONEqual(r, tc.got, tc.want)
+			checkFailures(t, r, tc.failure)
+		})
+	}
+}
@@ -0,0 +1,79 @@
+// =====================================================================================================================
+// == LICENSE:       Copyright (c) 2024 Kevin De Coninck
+// ==
+// ==                Permission is hereby granted, free of charge, to any person
+// ==                obtaining a copy of this software and associated documentation
+// ==                files (the "Software"), to deal in the Software without
+// ==                restriction, including without limitation the rights to use,
+// ==                copy, modify, merge, publish, distribute, sublicense, and/or sell
+// ==                copies of the Software, and to permit persons to whom the
+// ==                Software is furnished to do so, subject to the following
+// ==                conditions:
+// ==
+// ==                The above copyright notice and this permission notice shall be
+// ==                included in all copies or substantial portions of the Software.
+// ==
+// ==                THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// ==                EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// ==                OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// ==                NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// ==                HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// ==                WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// ==                FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+// ==                OTHER DEALINGS IN THE SOFTWARE.
+// =====================================================================================================================
+
+package assert
+
+import (
+	"reflect"
+	"testing"
+)
+
+// Len asserts that collection, which must be a slice, array, map, string or channel, has length n.
+func Len(tb testing.TB, collection any, n int) {
+	tb.Helper()
+
+	if l, ok := length(collection); !ok {
+		tb.Errorf("Len: %T doesn't have a length", collection)
+	} else if l != n {
+		tb.Errorf("Len: got length %d, want %d: %s", l, n, formatGoValue(optionsFor(tb), collection))
+	}
+}
+
+// Empty asserts that collection, which must be nil or a slice, array, map, string or channel, has length 0.
+func Empty(tb testing.TB, collection any) {
+	tb.Helper()
+
+	if l, ok := length(collection); !ok {
+		tb.Errorf("Empty: %T doesn't have a length", collection)
+	} else if l != 0 {
+		tb.Errorf("Empty: got length %d, want 0: %s", l, formatGoValue(optionsFor(tb), collection))
+	}
+}
+
+// NotEmpty asserts that collection, which must be a slice, array, map, string or channel, doesn't have length 0.
+func NotEmpty(tb testing.TB, collection any) {
+	tb.Helper()
+
+	if l, ok := length(collection); !ok {
+		tb.Errorf("NotEmpty: %T doesn't have a length", collection)
+	} else if l == 0 {
+		tb.Errorf("NotEmpty: got length 0: %s", formatGoValue(optionsFor(tb), collection))
+	}
+}
+
+// Returns the length of v and whether v has a length at all. A nil v has length 0.
+func length(v any) (int, bool) {
+	if v == nil {
+		return 0, true
+	}
+
+	switch rv := reflect.ValueOf(v); rv.Kind() {
+	case reflect.Array, reflect.Chan, reflect.Map, reflect.Slice, reflect.String:
+		return rv.Len(), true
+
+	default:
+		return 0, false
+	}
+}
@@ -0,0 +1,124 @@
+// =====================================================================================================================
+// == LICENSE:       Copyright (c) 2024 Kevin De Coninck
+// ==
+// ==                Permission is hereby granted, free of charge, to any person
+// ==                obtaining a copy of this software and associated documentation
+// ==                files (the "Software"), to deal in the Software without
+// ==                restriction, including without limitation the rights to use,
+// ==                copy, modify, merge, publish, distribute, sublicense, and/or sell
+// ==                copies of the Software, and to permit persons to whom the
+// ==                Software is furnished to do so, subject to the following
+// ==                conditions:
+// ==
+// ==                The above copyright notice and this permission notice shall be
+// ==                included in all copies or substantial portions of the Software.
+// ==
+// ==                THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// ==                EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// ==                OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// ==                NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// ==                HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// ==                WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// ==                FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+// ==                OTHER DEALINGS IN THE SOFTWARE.
+// =====================================================================================================================
+
+package assert_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/kdeconinck/seesharp/internal/assert"
+)
+
+func TestLenPasses(t *testing.T) {
+	t.Parallel()
+
+	ch := make(chan int, 3)
+	ch <- 1
+
+	r := newRecorder(t)
+
+	assert.Len(r, []int{1, 2}, 2)
+	assert.Len(r, [3]string{}, 3)
+	assert.Len(r, map[string]int{"a": 1}, 1)
+	assert.Len(r, "héllo", 6)
+	assert.Len(r, ch, 1)
+	assert.Len(r, nil, 0)
+	assert.Empty(r, nil)
+	assert.Empty(r, "")
+	assert.Empty(r, []int(nil))
+	assert.Empty(r, map[int]int{})
+	assert.Empty(r, make(chan int))
+	assert.NotEmpty(r, [1]int{})
+	assert.NotEmpty(r, "x")
+	assert.NotEmpty(r, ch)
+	checkFailures(t, r)
+}
+
+func TestLenFailures(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name    string
+		assert  func(tb *recorder)
+		failure string
+	}{
+		{
+			name:    "Slice",
+			assert:  func(tb *recorder) { assert.Len(tb, []int{1}, 2) },
+			failure: "Len: got length 1, want 2: []int{1}",
+		},
+		{
+			name:    "Map",
+			assert:  func(tb *recorder) { assert.Len(tb, map[string]bool{"a": true}, 0) },
+			failure: `Len: got length 1, want 0: map[string]bool{"a":true}`,
+		},
+		{
+			name:    "Nil",
+			assert:  func(tb *recorder) { assert.Len(tb, nil, 1) },
+			failure: "Len: got length 0, want 1: <nil>",
+		},
+		{
+			name:    "Unsupported",
+			assert:  func(tb *recorder) { assert.Len(tb, 42, 1) },
+			failure: "Len: int doesn't have a length",
+		},
+		{
+			name:    "Empty",
+			assert:  func(tb *recorder) { assert.Empty(tb, "x") },
+			failure: `Empty: got length 1, want 0: "x"`,
+		},
+		{
+			name:    "EmptyUnsupported",
+			assert:  func(tb *recorder) { assert.Empty(tb, struct{}{}) },
+			failure: "Empty: struct {} doesn't have a length",
+		},
+		{
+			name:    "NotEmpty",
+			assert:  func(tb *recorder) { assert.NotEmpty(tb, []string{}) },
+			failure: "NotEmpty: got length 0: []string{}",
+		},
+		{
+			name:    "NotEmptyUnsupported",
+			assert:  func(tb *recorder) { assert.NotEmpty(tb, 1.5) },
+			failure: "NotEmpty: float64 doesn't have a length",
+		},
+		{
+			name: "Truncated",
+			assert: func(tb *recorder) {
+				assert.SetOptions(tb, assert.Options{MaxWidth: 10})
+				assert.Len(tb, strings.Repeat("a", 20), 1)
+			},
+			failure: `Len: got length 20, want 1: "aaaaaaaaa…`,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			r := newRecorder(t)
+
+			tc.assert(r)
+			checkFailures(t, r, tc.failure)
+		})
+	}
+}
@@ -0,0 +1,89 @@
+// =====================================================================================================================
+// == LICENSE:       Copyright (c) 2024 Kevin De Coninck
+// ==
+// ==                Permission is hereby granted, free of charge, to any person
+// ==                obtaining a copy of this software and associated documentation
+// ==                files (the "Software"), to deal in the Software without
+// ==                restriction, including without limitation the rights to use,
+// ==                copy, modify, merge, publish, distribute, sublicense, and/or sell
+// ==                copies of the Software, and to permit persons to whom the
+// ==                Software is furnished to do so, subject to the following
+// ==                conditions:
+// ==
+// ==                The above copyright notice and this permission notice shall be
+// ==                included in all copies or substantial portions of the Software.
+// ==
+// ==                THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// ==                EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// ==                OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// ==                NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// ==                HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// ==                WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// ==                FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+// ==                OTHER DEALINGS IN THE SOFTWARE.
+// =====================================================================================================================
+
+package assert
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// Matches ANSI escape sequences: CSI sequences (such as colors and cursor movement), OSC sequences (such as
+// hyperlinks) and the remaining escape sequences, which consist of intermediate bytes followed by a final byte (such as
+// "ESC ( B", which selects the ASCII character set).
+var ansiRegex = regexp.MustCompile(`\x1b\[[0-?]*[ -/]*[@-~]|\x1b\][^\x07\x1b]*(?:\x07|\x1b\\)|\x1b[ -/]*[0-~]`)
+
+// EqualStripped asserts that got and want are equal after normalizing both of them.
+// Normalizing removes ANSI escape sequences, trailing whitespace on every line and trailing empty lines, so rendered
+// terminal output can be compared without embedding escape codes in the expected value. Every line that differs is
+// reported with its 1-based line number.
+func EqualStripped(tb testing.TB, got, want string) {
+	tb.Helper()
+
+	var (
+		opts        = optionsFor(tb)
+		gotL, wantL = normalizeLines(got), normalizeLines(want)
+		diffs       []string
+	)
+
+	for idx := range max(len(gotL), len(wantL)) {
+		switch {
+		case idx >= len(gotL):
+			diffs = append(diffs, fmt.Sprintf("line %d: missing, want %s", idx+1, formatValue(opts, strconv.Quote(wantL[idx]))))
+		case idx >= len(wantL):
+			diffs = append(diffs, fmt.Sprintf("line %d: unexpected, got %s", idx+1, formatValue(opts, strconv.Quote(gotL[idx]))))
+		case gotL[idx] != wantL[idx]:
+			gotV, wantV := formatPair(opts, strconv.Quote(gotL[idx]), strconv.Quote(wantL[idx]))
+			diffs = append(diffs, fmt.Sprintf("line %d: got %s, want %s", idx+1, gotV, wantV))
+		}
+	}
+
+	if len(diffs) > 0 {
+		failDiff(tb, "EqualStripped", diffs)
+	}
+}
+
+// StripANSI returns v without any ANSI escape sequences.
+func StripANSI(v string) string {
+	return ansiRegex.ReplaceAllString(v, "")
+}
+
+// Returns the lines of v without ANSI escape sequences, trailing whitespace and trailing empty lines.
+func normalizeLines(v string) []string {
+	lines := strings.Split(strings.ReplaceAll(StripANSI(v), "\r\n", "\n"), "\n")
+
+	for idx, line := range lines {
+		lines[idx] = strings.TrimRight(line, " \t\r")
+	}
+
+	for len(lines) > 0 && lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+
+	return lines
+}
@@ -0,0 +1,114 @@
+// =====================================================================================================================
+// == LICENSE:       Copyright (c) 2024 Kevin De Coninck
+// ==
+// ==                Permission is hereby granted, free of charge, to any person
+// ==                obtaining a copy of this software and associated documentation
+// ==                files (the "Software"), to deal in the Software without
+// ==                restriction, including without limitation the rights to use,
+// ==                copy, modify, merge, publish, distribute, sublicense, and/or sell
+// ==                copies of the Software, and to permit persons to whom the
+// ==                Software is furnished to do so, subject to the following
+// ==                conditions:
+// ==
+// ==                The above copyright notice and this permission notice shall be
+// ==                included in all copies or substantial portions of the Software.
+// ==
+// ==                THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// ==                EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// ==                OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// ==                NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// ==                HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// ==                WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// ==                FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+// ==                OTHER DEALINGS IN THE SOFTWARE.
+// =====================================================================================================================
+
+package assert_test
+
+import (
+	"testing"
+
+	"github.com/kdeconinck/seesharp/internal/assert"
+)
+
+func TestStripANSI(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name  string
+		input string
+		want  string
+	}{
+		{name: "Plain", input: "no escapes", want: "no escapes"},
+		{name: "CSIColor", input: "\x1b[1;32m✓\x1b[0m passed", want: "✓ passed"},
+		{name: "CSICursor", input: "a\x1b[2Kb\x1b[10;20Hc\x1b[?25l", want: "abc"},
+		{name: "OSCWithBEL", input: "\x1b]0;title\x07text", want: "text"},
+		{name: "OSCWithST", input: "\x1b]8;;https://example.com\x1b\\link\x1b]8;;\x1b\\", want: "link"},
+		{name: "TwoCharacter", input: "\x1bMup\x1b7save\x1b=\x1bcreset", want: "upsavereset"},
+		{name: "CharacterSet", input: "\x1b[1mbold\x1b(B\x1b[m plain\x1b)0", want: "bold plain"},
+	} {
+		if got := assert.StripANSI(tc.input); got != tc.want {
+			t.Errorf("%s: StripANSI(%q) = %q, want %q", tc.name, tc.input, got, tc.want)
+		}
+	}
+}
+
+func TestEqualStripped(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name      string
+		got, want string
+	}{
+		{name: "Escapes", got: "\x1b[31m✗\x1b[0m failed\n\x1b]8;;x\x07link\x1b]8;;\x07", want: "✗ failed\nlink"},
+		{name: "CRLF", got: "line 1\r\nline 2\r\n", want: "line 1\nline 2"},
+		{name: "TrailingWhitespace", got: "a  \t\nb \x1b[0m ", want: "a\nb"},
+		{name: "TrailingBlankLines", got: "a\n\n  \n\x1b[0m\n", want: "a"},
+		{name: "Empty", got: "\x1b[0m\n", want: ""},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			r := newRecorder(t)
+
+			assert.EqualStripped(r, tc.got, tc.want)
+			checkFailures(t, r)
+		})
+	}
+}
+
+func TestEqualStrippedFailures(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name      string
+		got, want string
+		failure   string
+	}{
+		{
+			name: "Line",
+			got:  "a\n\x1b[1mb\x1b[0m\nc", want: "a\nx\nc",
+			failure: "EqualStripped: 1 difference(s):\n  line 2: got \"b\", want \"x\"",
+		},
+		{
+			name: "LeadingWhitespace",
+			got:  "  a", want: "a",
+			failure: "EqualStripped: 1 difference(s):\n  line 1: got \"  a\", want \"a\"",
+		},
+		{
+			name: "MissingLine",
+			got:  "a", want: "a\nb",
+			failure: "EqualStripped: 1 difference(s):\n  line 2: missing, want \"b\"",
+		},
+		{
+			name: "UnexpectedLines",
+			got:  "a\nb\nc", want: "a",
+			failure: "EqualStripped: 2 difference(s):\n  line 2: unexpected, got \"b\"\n  line 3: unexpected, got \"c\"",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			r := newRecorder(t)
+
+			assert.EqualStripped(r, tc.got, tc.want)
+			checkFailures(t, r, tc.failure)
+		})
+	}
+}
@@ -0,0 +1,209 @@
+// =====================================================================================================================
+// == LICENSE:       Copyright (c) 2024 Kevin De Coninck
+// ==
+// ==                Permission is hereby granted, free of charge, to any person
+// ==                obtaining a copy of this software and associated documentation
+// ==                files (the "Software"), to deal in the Software without
+// ==                restriction, including without limitation the rights to use,
+// ==                copy, modify, merge, publish, distribute, sublicense, and/or sell
+// ==                copies of the Software, and to permit persons to whom the
+// ==                Software is furnished to do so, subject to the following
+// ==                conditions:
+// ==
+// ==                The above copyright notice and this permission notice shall be
+// ==                included in all copies or substantial portions of the Software.
+// ==
+// ==                THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// ==                EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// ==                OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// ==                NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// ==                HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// ==                WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// ==                FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+// ==                OTHER DEALINGS IN THE SOFTWARE.
+// =====================================================================================================================
+
+package assert
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// XMLEqual asserts that got and want are semantically equal XML documents.
+// Attribute order, namespace prefixes, comments, processing instructions and whitespace surrounding text are ignored,
+// while the order of child elements is significant. Every difference is reported with the path to the element in which
+// it occurs, such as "/assemblies/assembly[2]/@name".
+func XMLEqual(tb testing.TB, got, want string) {
+	tb.Helper()
+
+	gotN, err := decodeXML(got)
+
+	if err != nil {
+		tb.Errorf("XMLEqual: got is not valid XML: %v", err)
+
+		return
+	}
+
+	wantN, err := decodeXML(want)
+
+	if err != nil {
+		tb.Errorf("XMLEqual: want is not valid XML: %v", err)
+
+		return
+	}
+
+	if diffs := diffXML(optionsFor(tb), "/"+xmlName(wantN.name), gotN, wantN, nil); len(diffs) > 0 {
+		failDiff(tb, "XMLEqual", diffs)
+	}
+}
+
+// An xmlNode is an element of an XML document.
+type xmlNode struct {
+	name     xml.Name
+	attrs    map[xml.Name]string
+	text     string
+	children []*xmlNode
+}
+
+// Decodes v, which must contain exactly one root element.
+func decodeXML(v string) (*xmlNode, error) {
+	var (
+		dec   = xml.NewDecoder(strings.NewReader(v))
+		stack []*xmlNode
+		root  *xmlNode
+	)
+
+	for {
+		tok, err := dec.Token()
+
+		if err == io.EOF {
+			break
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		switch tok := tok.(type) {
+		case xml.StartElement:
+			if root != nil && len(stack) == 0 {
+				return nil, fmt.Errorf("multiple root elements")
+			}
+
+			node := &xmlNode{name: tok.Name, attrs: make(map[xml.Name]string, len(tok.Attr))}
+
+			for _, attr := range tok.Attr {
+				// Element and attribute names are compared after namespace resolution, so the prefixes bound by
+				// namespace declarations don't matter.
+				if attr.Name.Space == "xmlns" || attr.Name.Space == "" && attr.Name.Local == "xmlns" {
+					continue
+				}
+
+				node.attrs[attr.Name] = attr.Value
+			}
+
+			if len(stack) == 0 {
+				root = node
+			} else {
+				parent := stack[len(stack)-1]
+				parent.children = append(parent.children, node)
+			}
+
+			stack = append(stack, node)
+
+		case xml.EndElement:
+			stack = stack[:len(stack)-1]
+
+		case xml.CharData:
+			if len(stack) > 0 {
+				stack[len(stack)-1].text += string(tok)
+			}
+		}
+	}
+
+	if root == nil {
+		return nil, fmt.Errorf("no root element")
+	}
+
+	return root, nil
+}
+
+// Appends a description of each difference between got and want, which are located at path, to diffs.
+func diffXML(opts Options, path string, got, want *xmlNode, diffs []string) []string {
+	if got.name != want.name {
+		return append(diffs, fmt.Sprintf("%s: got element <%s>, want <%s>", path, xmlName(got.name), xmlName(want.name)))
+	}
+
+	for _, name := range sortedNames(want.attrs) {
+		if _, ok := got.attrs[name]; !ok {
+			diffs = append(diffs, fmt.Sprintf("%s/@%s: missing, want %s", path, xmlName(name),
+				formatValue(opts, strconv.Quote(want.attrs[name]))))
+		}
+	}
+
+	for _, name := range sortedNames(got.attrs) {
+		wantV, ok := want.attrs[name]
+
+		switch {
+		case !ok:
+			diffs = append(diffs, fmt.Sprintf("%s/@%s: unexpected, got %s", path, xmlName(name),
+				formatValue(opts, strconv.Quote(got.attrs[name]))))
+		case got.attrs[name] != wantV:
+			gotS, wantS := formatPair(opts, strconv.Quote(got.attrs[name]), strconv.Quote(wantV))
+			diffs = append(diffs, fmt.Sprintf("%s/@%s: got %s, want %s", path, xmlName(name), gotS, wantS))
+		}
+	}
+
+	if gotT, wantT := strings.TrimSpace(got.text), strings.TrimSpace(want.text); gotT != wantT {
+		gotS, wantS := formatPair(opts, strconv.Quote(gotT), strconv.Quote(wantT))
+		diffs = append(diffs, fmt.Sprintf("%s/text(): got %s, want %s", path, gotS, wantS))
+	}
+
+	if len(got.children) != len(want.children) {
+		diffs = append(diffs, fmt.Sprintf("%s: got %d child element(s), want %d", path, len(got.children),
+			len(want.children)))
+	}
+
+	// Child elements are identified by their 1-based position among the siblings with the same name, as in XPath.
+	positions := make(map[xml.Name]int)
+
+	for idx := range min(len(got.children), len(want.children)) {
+		name := want.children[idx].name
+		positions[name]++
+
+		childPath := path + "/" + xmlName(name) + "[" + strconv.Itoa(positions[name]) + "]"
+		diffs = diffXML(opts, childPath, got.children[idx], want.children[idx], diffs)
+	}
+
+	return diffs
+}
+
+// Returns the names in m in ascending order.
+func sortedNames(m map[xml.Name]string) []xml.Name {
+	names := make([]xml.Name, 0, len(m))
+
+	for name := range m {
+		names = append(names, name)
+	}
+
+	slices.SortFunc(names, func(a, b xml.Name) int {
+		return strings.Compare(xmlName(a), xmlName(b))
+	})
+
+	return names
+}
+
+// Returns the printable form of name, prefixed with its namespace when it has one.
+func xmlName(name xml.Name) string {
+	if name.Space == "" {
+		return name.Local
+	}
+
+	return "{" + name.Space + "}" + name.Local
+}
@@ -0,0 +1,110 @@
+// =====================================================================================================================
+// == LICENSE:       Copyright (c) 2024 Kevin De Coninck
+// ==
+// ==                Permission is hereby granted, free of charge, to any person
+// ==                obtaining a copy of this software and associated documentation
+// ==                files (the "Software"), to deal in the Software without
+// ==                restriction, including without limitation the rights to use,
+// ==                copy, modify, merge, publish, distribute, sublicense, and/or sell
+// ==                copies of the Software, and to permit persons to whom the
+// ==                Software is furnished to do so, subject to the following
+// ==                conditions:
+// ==
+// ==                The above copyright notice and this permission notice shall be
+// ==                included in all copies or substantial portions of the Software.
+// ==
+// ==                THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// ==                EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// ==                OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// ==                NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// ==                HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// ==                WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// ==                FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+// ==                OTHER DEALINGS IN THE SOFTWARE.
+// =====================================================================================================================
+
+package assert_test
+
+import (
+	"testing"
+
+	"github.com/kdeconinck/seesharp/internal/assert"
+)
+
+func TestXMLEqual(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name      string
+		got, want string
+	}{
+		{name: "Identical", got: `<a x="1"/>`, want: `<a x="1"></a>`},
+		{
+			name: "AttributeOrderAndWhitespace",
+			got:  "<?xml version=\"1.0\"?>\n<a x=\"1\" y=\"2\">\n  <b> text </b>\n</a>",
+			want: `<a y="2" x="1"><b>text</b></a>`,
+		},
+		{name: "Comments", got: `<a><!-- note --><b/></a>`, want: `<a><b/></a>`},
+		{
+			name: "NamespacePrefixes",
+			got:  `<p:a xmlns:p="urn:x" p:v="1"><p:b/></p:a>`,
+			want: `<q:a xmlns:q="urn:x" q:v="1"><q:b/></q:a>`,
+		},
+		{name: "DefaultNamespace", got: `<a xmlns="urn:x"><b/></a>`, want: `<p:a xmlns:p="urn:x"><p:b/></p:a>`},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			r := newRecorder(t)
+
+			assert.XMLEqual(r, tc.got, tc.want)
+			checkFailures(t, r)
+		})
+	}
+}
+
+func TestXMLEqualFailures(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name      string
+		got, want string
+		failure   string
+	}{
+		{
+			name: "Attributes",
+			got:  `<a x="1" z="3"/>`, want: `<a x="2" y="2"/>`,
+			failure: "XMLEqual: 3 difference(s):\n  /a/@y: missing, want \"2\"\n  /a/@x: got \"1\", want \"2\"\n" +
+				"  /a/@z: unexpected, got \"3\"",
+		},
+		{
+			name: "Text",
+			got:  `<a><b>t</b><b><c>u</c></b></a>`, want: `<a><b>t</b><b><c>v</c></b></a>`,
+			failure: "XMLEqual: 1 difference(s):\n  /a/b[2]/c[1]/text(): got \"u\", want \"v\"",
+		},
+		{
+			name: "Children",
+			got:  `<a><b/><c/></a>`, want: `<a><b/><d/><e/></a>`,
+			failure: "XMLEqual: 2 difference(s):\n  /a: got 2 child element(s), want 3\n" +
+				"  /a/d[1]: got element <c>, want <d>",
+		},
+		{
+			name: "Namespace",
+			got:  `<a xmlns="urn:x"/>`, want: `<a xmlns="urn:y"/>`,
+			failure: "XMLEqual: 1 difference(s):\n  /{urn:y}a: got element <{urn:x}a>, want <{urn:y}a>",
+		},
+		{name: "InvalidGot", got: `<a>`, want: `<a/>`, failure: "XMLEqual: got is not valid XML: XML syntax error " +
+			"on line 1: unexpected EOF"},
+		{name: "Empty", got: `<a/>`, want: ``, failure: "XMLEqual: want is not valid XML: no root element"},
+		{
+			name: "MultipleRoots",
+			got:  `<a/><b/>`, want: `<a/>`,
+			failure: "XMLEqual: got is not valid XML: multiple root elements",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			r := newRecorder(t)
+
+			assert.XMLEqual(r, tc.got, tc.want)
+			checkFailures(t, r, tc.failure)
+		})
+	}
+}
@@ -0,0 +1,70 @@
+// =====================================================================================================================
+// == LICENSE:       Copyright (c) 2024 Kevin De Coninck
+// ==
+// ==                Permission is hereby granted, free of charge, to any person
+// ==                obtaining a copy of this software and associated documentation
+// ==                files (the "Software"), to deal in the Software without
+// ==                restriction, including without limitation the rights to use,
+// ==                copy, modify, merge, publish, distribute, sublicense, and/or sell
+// ==                copies of the Software, and to permit persons to whom the
+// ==                Software is furnished to do so, subject to the following
+// ==                conditions:
+// ==
+// ==                The above copyright notice and this permission notice shall be
+// ==                included in all copies or substantial portions of the Software.
+// ==
+// ==                THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// ==                EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// ==                OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// ==                NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// ==                HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// ==                WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// ==                FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+// ==                OTHER DEALINGS IN THE SOFTWARE.
+// =====================================================================================================================
+
+// Package camelcase splits camel-cased identifiers, such as .NET type and method names, into separate words.
+//
+// Words are separated on transitions between lowercase letters, uppercase letters, digits and any other character.
+// A run of uppercase letters followed by a lowercase letter is treated as an acronym followed by a new word, so
+// "HTTPServer" is split into "HTTP" and "Server".
+package camelcase
+
+import "unicode"
+
+// A class is the category of a rune, used to decide where one word ends and the next begins.
+type class int
+
+// The categories a rune can belong to.
+const (
+	lower class = iota
+	upper
+	digit
+	other
+)
+
+// Returns the class of r.
+func classOf(r rune) class {
+	switch {
+	case unicode.IsLower(r):
+		return lower
+	case unicode.IsUpper(r):
+		return upper
+	case unicode.IsDigit(r):
+		return digit
+	default:
+		return other
+	}
+}
+
+// Split splits v into words.
+func Split(v string) []string {
+	spans := SplitIndex(v)
+	words := make([]string, len(spans))
+
+	for idx, span := range spans {
+		words[idx] = v[span.Start:span.End]
+	}
+
+	return words
+}
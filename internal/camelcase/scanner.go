@@ -0,0 +1,160 @@
+// =====================================================================================================================
+// == LICENSE:       Copyright (c) 2024 Kevin De Coninck
+// ==
+// ==                Permission is hereby granted, free of charge, to any person
+// ==                obtaining a copy of this software and associated documentation
+// ==                files (the "Software"), to deal in the Software without
+// ==                restriction, including without limitation the rights to use,
+// ==                copy, modify, merge, publish, distribute, sublicense, and/or sell
+// ==                copies of the Software, and to permit persons to whom the
+// ==                Software is furnished to do so, subject to the following
+// ==                conditions:
+// ==
+// ==                The above copyright notice and this permission notice shall be
+// ==                included in all copies or substantial portions of the Software.
+// ==
+// ==                THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// ==                EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// ==                OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// ==                NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// ==                HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// ==                WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// ==                FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+// ==                OTHER DEALINGS IN THE SOFTWARE.
+// =====================================================================================================================
+
+package camelcase
+
+import (
+	"bufio"
+	"io"
+	"unicode/utf8"
+)
+
+// A Scanner reads camel-cased text from an io.Reader and yields its words one at a time, without materializing all
+// the words in memory.
+//
+// Successive calls to Scan step through the words of the input. Scanning stops at the end of the input or at the
+// first I/O error. Bytes that aren't valid UTF-8 are kept as-is, so the words match those returned by Split.
+type Scanner struct {
+	rdr     *bufio.Reader
+	word    []byte            // The bytes of the word that is currently being built.
+	cls     class             // The class of the word that is currently being built.
+	last    int               // The offset in word of its last rune.
+	upperCt int               // The number of runes in word when it's a run of uppercase letters.
+	raw     [utf8.UTFMax]byte // The bytes of the rune that was read last.
+	token   []byte
+	err     error
+	done    bool
+}
+
+// NewScanner returns a new Scanner that reads from rdr.
+func NewScanner(rdr io.Reader) *Scanner {
+	return &Scanner{rdr: bufio.NewReader(rdr)}
+}
+
+// Scan advances the Scanner to the next word, which is then available through the Text method.
+// It returns false when there are no more words, either because the end of the input was reached or because an error
+// occurred. After Scan returns false, the Err method returns the error that occurred, if any.
+func (s *Scanner) Scan() bool {
+	if s.done {
+		return false
+	}
+
+	for {
+		r, size, err := s.rdr.ReadRune()
+
+		if err != nil {
+			s.done = true
+
+			if err != io.EOF {
+				s.err = err
+			}
+
+			if len(s.word) == 0 {
+				return false
+			}
+
+			s.token = append(s.token[:0], s.word...)
+			s.word = s.word[:0]
+
+			return true
+		}
+
+		n := utf8.EncodeRune(s.raw[:], r)
+
+		if r == utf8.RuneError && size == 1 {
+			// ReadRune replaced an invalid byte by U+FFFD; read the original byte again.
+			_ = s.rdr.UnreadRune()
+			s.raw[0], _ = s.rdr.ReadByte()
+			n = 1
+		}
+
+		if s.push(r, s.raw[:n]) {
+			return true
+		}
+	}
+}
+
+// Text returns the most recent word produced by a call to Scan.
+func (s *Scanner) Text() string {
+	return string(s.token)
+}
+
+// Bytes returns the most recent word produced by a call to Scan.
+// Unlike Text, it doesn't allocate. The underlying array may be overwritten by a subsequent call to Scan.
+func (s *Scanner) Bytes() []byte {
+	return s.token
+}
+
+// Err returns the first non-EOF error that was encountered by the Scanner.
+func (s *Scanner) Err() error {
+	return s.err
+}
+
+// Adds r, which is encoded as b, to the word that is currently being built.
+// When r starts a new word, the completed word is stored as the current token and true is returned.
+func (s *Scanner) push(r rune, b []byte) bool {
+	c := classOf(r)
+
+	switch {
+	case len(s.word) == 0 || c == s.cls:
+		s.append(b, c)
+
+		return false
+
+	case s.cls == upper && c == lower:
+		if s.upperCt == 1 {
+			s.append(b, c)
+
+			return false
+		}
+
+		// The last uppercase letter is the first letter of the next word.
+		s.token = append(s.token[:0], s.word[:s.last]...)
+		s.word = append(s.word[:0], s.word[s.last:]...)
+		s.cls, s.last = lower, 0
+		s.append(b, c)
+
+		return true
+	}
+
+	s.token = append(s.token[:0], s.word...)
+	s.word, s.upperCt = s.word[:0], 0
+	s.append(b, c)
+
+	return true
+}
+
+// Appends b, the encoding of a rune of class c, to the word that is currently being built.
+func (s *Scanner) append(b []byte, c class) {
+	s.last = len(s.word)
+	s.word = append(s.word, b...)
+	s.cls = c
+
+	if c == upper {
+		s.upperCt++
+	} else {
+		s.upperCt = 0
+	}
+}
@@ -0,0 +1,136 @@
+// =====================================================================================================================
+// == LICENSE:       Copyright (c) 2024 Kevin De Coninck
+// ==
+// ==                Permission is hereby granted, free of charge, to any person
+// ==                obtaining a copy of this software and associated documentation
+// ==                files (the "Software"), to deal in the Software without
+// ==                restriction, including without limitation the rights to use,
+// ==                copy, modify, merge, publish, distribute, sublicense, and/or sell
+// ==                copies of the Software, and to permit persons to whom the
+// ==                Software is furnished to do so, subject to the following
+// ==                conditions:
+// ==
+// ==                The above copyright notice and this permission notice shall be
+// ==                included in all copies or substantial portions of the Software.
+// ==
+// ==                THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// ==                EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// ==                OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// ==                NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// ==                HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// ==                WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// ==                FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+// ==                OTHER DEALINGS IN THE SOFTWARE.
+// =====================================================================================================================
+
+package camelcase_test
+
+import (
+	"errors"
+	"io"
+	"slices"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"github.com/kdeconinck/seesharp/internal/camelcase"
+)
+
+// Returns all the words produced by scanning rdr, together with the error of the Scanner.
+func scanAll(rdr io.Reader) ([]string, error) {
+	var (
+		scanner = camelcase.NewScanner(rdr)
+		words   []string
+	)
+
+	for scanner.Scan() {
+		words = append(words, scanner.Text())
+	}
+
+	return words, scanner.Err()
+}
+
+func TestScanner(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		input string
+		want  []string
+	}{
+		{input: "", want: nil},
+		{input: "lowercase", want: []string{"lowercase"}},
+		{input: "Class", want: []string{"Class"}},
+		{input: "MyClass", want: []string{"My", "Class"}},
+		{input: "HTTPServer", want: []string{"HTTP", "Server"}},
+		{input: "GetHTTP", want: []string{"Get", "HTTP"}},
+		{input: "ABC", want: []string{"ABC"}},
+		{input: "aB", want: []string{"a", "B"}},
+		{input: "Version2Beta", want: []string{"Version", "2", "Beta"}},
+		{input: "Utf8Bytes", want: []string{"Utf", "8", "Bytes"}},
+		{input: "Should_Return_Null", want: []string{"Should", "_", "Return", "_", "Null"}},
+		{input: "ÜberTestÄÖÜber", want: []string{"Über", "Test", "ÄÖ", "Über"}},
+		{input: "a\xffb", want: []string{"a", "\xff", "b"}},
+		{input: "A\xff\xfeB", want: []string{"A", "\xff\xfe", "B"}},
+	} {
+		got, err := scanAll(strings.NewReader(tc.input))
+
+		if err != nil || !slices.Equal(got, tc.want) {
+			t.Errorf("Scan(%q) = %q, %v, want %q, <nil>", tc.input, got, err, tc.want)
+		}
+	}
+}
+
+func TestScannerReaderError(t *testing.T) {
+	t.Parallel()
+
+	errRead := errors.New("read failed")
+	got, err := scanAll(io.MultiReader(strings.NewReader("MyClass"), iotest.ErrReader(errRead)))
+
+	if want := []string{"My", "Class"}; !slices.Equal(got, want) || !errors.Is(err, errRead) {
+		t.Errorf("Scan = %q, %v, want %q, %v", got, err, want, errRead)
+	}
+}
+
+func TestScannerOneByteReader(t *testing.T) {
+	t.Parallel()
+
+	input := "ÜberHTTPServer\xffV2"
+	got, err := scanAll(iotest.OneByteReader(strings.NewReader(input)))
+
+	if want := camelcase.Split(input); err != nil || !slices.Equal(got, want) {
+		t.Errorf("Scan(%q) = %q, %v, want %q, <nil>", input, got, err, want)
+	}
+}
+
+func TestScannerMatchesSplitIndex(t *testing.T) {
+	t.Parallel()
+
+	for _, input := range []string{"", "MyHTTPClient", "Ab\xffCd", "\xff", "x\xe2\x82", "\xef\xbf\xbdA", "日本Test"} {
+		got, err := scanAll(strings.NewReader(input))
+
+		var want []string
+
+		for _, span := range camelcase.SplitIndex(input) {
+			want = append(want, input[span.Start:span.End])
+		}
+
+		if err != nil || !slices.Equal(got, want) {
+			t.Errorf("Scan(%q) = %q, %v, SplitIndex gives %q", input, got, err, want)
+		}
+	}
+}
+
+func BenchmarkScanner(b *testing.B) {
+	input := strings.Repeat("ShouldReturnHTTPErrorWhenInput2IsNull_", 1_000_000/38)
+
+	b.ReportAllocs()
+	b.SetBytes(int64(len(input)))
+
+	for range b.N {
+		scanner := camelcase.NewScanner(strings.NewReader(input))
+
+		for scanner.Scan() {
+			_ = scanner.Bytes()
+		}
+	}
+}
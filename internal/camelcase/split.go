@@ -0,0 +1,78 @@
+// =====================================================================================================================
+// == LICENSE:       Copyright (c) 2024 Kevin De Coninck
+// ==
+// ==                Permission is hereby granted, free of charge, to any person
+// ==                obtaining a copy of this software and associated documentation
+// ==                files (the "Software"), to deal in the Software without
+// ==                restriction, including without limitation the rights to use,
+// ==                copy, modify, merge, publish, distribute, sublicense, and/or sell
+// ==                copies of the Software, and to permit persons to whom the
+// ==                Software is furnished to do so, subject to the following
+// ==                conditions:
+// ==
+// ==                The above copyright notice and this permission notice shall be
+// ==                included in all copies or substantial portions of the Software.
+// ==
+// ==                THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// ==                EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// ==                OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// ==                NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// ==                HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// ==                WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// ==                FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+// ==                OTHER DEALINGS IN THE SOFTWARE.
+// =====================================================================================================================
+
+package camelcase
+
+// A Span is the position of a single word in a camel-cased identifier.
+// Start and End are byte offsets into the identifier, such that v[Start:End] is the word.
+type Span struct {
+	Start int
+	End   int
+}
+
+// SplitIndex splits v into words and returns the position of each word in v.
+// The spans are ordered, contiguous and cover the whole of v.
+func SplitIndex(v string) []Span {
+	var (
+		spans   []Span
+		cls     class
+		start   int
+		last    int
+		upperCt int
+	)
+
+	for i, r := range v {
+		c := classOf(r)
+
+		switch {
+		case i == 0 || c == cls:
+
+		case cls == upper && c == lower:
+			if upperCt > 1 {
+				// The last uppercase letter is the first letter of the next word.
+				spans = append(spans, Span{Start: start, End: last})
+				start = last
+			}
+
+		default:
+			spans = append(spans, Span{Start: start, End: i})
+			start = i
+		}
+
+		if c == upper {
+			upperCt++
+		} else {
+			upperCt = 0
+		}
+
+		cls, last = c, i
+	}
+
+	if len(v) > 0 {
+		spans = append(spans, Span{Start: start, End: len(v)})
+	}
+
+	return spans
+}
@@ -0,0 +1,79 @@
+// =====================================================================================================================
+// == LICENSE:       Copyright (c) 2024 Kevin De Coninck
+// ==
+// ==                Permission is hereby granted, free of charge, to any person
+// ==                obtaining a copy of this software and associated documentation
+// ==                files (the "Software"), to deal in the Software without
+// ==                restriction, including without limitation the rights to use,
+// ==                copy, modify, merge, publish, distribute, sublicense, and/or sell
+// ==                copies of the Software, and to permit persons to whom the
+// ==                Software is furnished to do so, subject to the following
+// ==                conditions:
+// ==
+// ==                The above copyright notice and this permission notice shall be
+// ==                included in all copies or substantial portions of the Software.
+// ==
+// ==                THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// ==                EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// ==                OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// ==                NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// ==                HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// ==                WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// ==                FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+// ==                OTHER DEALINGS IN THE SOFTWARE.
+// =====================================================================================================================
+
+package camelcase_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/kdeconinck/seesharp/internal/camelcase"
+)
+
+func TestSplitIndex(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		input string
+		want  []camelcase.Span
+	}{
+		{input: "", want: nil},
+		{input: "Test", want: []camelcase.Span{{Start: 0, End: 4}}},
+		{input: "MyHTTPClient", want: []camelcase.Span{{Start: 0, End: 2}, {Start: 2, End: 6}, {Start: 6, End: 12}}},
+		{input: "Über2", want: []camelcase.Span{{Start: 0, End: 5}, {Start: 5, End: 6}}},
+		{input: "a\xffb", want: []camelcase.Span{{Start: 0, End: 1}, {Start: 1, End: 2}, {Start: 2, End: 3}}},
+	} {
+		if got := camelcase.SplitIndex(tc.input); !slices.Equal(got, tc.want) {
+			t.Errorf("SplitIndex(%q) = %v, want %v", tc.input, got, tc.want)
+		}
+	}
+}
+
+func TestSplitIndexSpansCoverInput(t *testing.T) {
+	t.Parallel()
+
+	for _, input := range []string{
+		"ShouldReturnNullWhenInputIsEmpty", "IOErrorHandler", "Test_Case_42", "ÄÖÜberÉcole", "x\xe2\x82Y", "日本語Test",
+	} {
+		var (
+			spans = camelcase.SplitIndex(input)
+			sb    []byte
+			end   int
+		)
+
+		for _, span := range spans {
+			if span.Start != end || span.End <= span.Start {
+				t.Errorf("SplitIndex(%q): span %v doesn't follow offset %d", input, span, end)
+			}
+
+			sb = append(sb, input[span.Start:span.End]...)
+			end = span.End
+		}
+
+		if end != len(input) || string(sb) != input {
+			t.Errorf("SplitIndex(%q): spans join to %q, want the input", input, sb)
+		}
+	}
+}
@@ -0,0 +1,304 @@
+// =====================================================================================================================
+// == LICENSE:       Copyright (c) 2024 Kevin De Coninck
+// ==
+// ==                Permission is hereby granted, free of charge, to any person
+// ==                obtaining a copy of this software and associated documentation
+// ==                files (the "Software"), to deal in the Software without
+// ==                restriction, including without limitation the rights to use,
+// ==                copy, modify, merge, publish, distribute, sublicense, and/or sell
+// ==                copies of the Software, and to permit persons to whom the
+// ==                Software is furnished to do so, subject to the following
+// ==                conditions:
+// ==
+// ==                The above copyright notice and this permission notice shall be
+// ==                included in all copies or substantial portions of the Software.
+// ==
+// ==                THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// ==                EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// ==                OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// ==                NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// ==                HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// ==                WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// ==                FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+// ==                OTHER DEALINGS IN THE SOFTWARE.
+// =====================================================================================================================
+
+// Package gosentence converts identifiers, such as test method names, into human-readable sentences.
+//
+// The identifier is split into words using the rules of package camelcase. Underscores, dashes, dots and spaces
+// separate words and are dropped. The first word is capitalized and the other words are lowercased, except for
+// acronyms such as "HTTP", which are kept as-is. For example, "ShouldReturnHTTPError_WhenInputIsNull" is converted into
+// "Should return HTTP error when input is null".
+package gosentence
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/kdeconinck/seesharp/internal/camelcase"
+)
+
+// Options controls the optional smoothing rules applied when converting an identifier.
+// The zero value applies none of them.
+type Options struct {
+	// CollapseDuplicates removes a word that equals the word before it, ignoring case, so "TestTest" becomes "Test".
+	CollapseDuplicates bool
+
+	// Articles corrects the article "a" or "an" to agree with the sound of the next word, so "ReturnsAError" becomes
+	// "Returns an error" and "ReturnsAnURL" becomes "Returns a URL".
+	Articles bool
+
+	// Pluralize makes a word that follows a number agree with it, so "Returns1Items" becomes "Returns 1 item" and
+	// "Returns2Item" becomes "Returns 2 items".
+	Pluralize bool
+
+	// Clauses are the test name patterns whose condition is separated from the rest of the sentence by a comma, so
+	// "ShouldReturnErrorWhenInputIsNull" becomes "Should return error, when input is null". Use DefaultClauses for the
+	// common patterns, or a repository's own set.
+	Clauses []Clause
+}
+
+// A Clause describes a test name pattern such as "Should...When...".
+// Sentences that start with Lead get a comma before the first occurrence of Keyword, unless Keyword is the last word.
+// Both are compared ignoring case, and an empty Lead matches every sentence.
+type Clause struct {
+	Lead    string
+	Keyword string
+}
+
+// DefaultClauses are the Should/Returns/Throws patterns commonly used to name .NET tests.
+var DefaultClauses = []Clause{
+	{Lead: "should", Keyword: "when"},
+	{Lead: "should", Keyword: "given"},
+	{Lead: "returns", Keyword: "when"},
+	{Lead: "returns", Keyword: "given"},
+	{Lead: "throws", Keyword: "when"},
+	{Lead: "throws", Keyword: "given"},
+}
+
+// Convert converts name into a sentence, applying the smoothing rules enabled in opts.
+func Convert(name string, opts Options) string {
+	words := splitWords(name)
+
+	if opts.CollapseDuplicates {
+		words = collapseDuplicates(words)
+	}
+
+	for idx, word := range words {
+		words[idx] = caseWord(word, idx == 0)
+	}
+
+	if opts.Articles {
+		fixArticles(words)
+	}
+
+	if opts.Pluralize {
+		fixPlurals(words)
+	}
+
+	for _, clause := range opts.Clauses {
+		insertComma(words, clause)
+	}
+
+	return strings.Join(words, " ")
+}
+
+// Returns the words of name, without separators.
+func splitWords(name string) []string {
+	var words []string
+
+	for _, word := range camelcase.Split(name) {
+		if strings.Trim(word, "_-. ") != "" {
+			words = append(words, word)
+		}
+	}
+
+	return words
+}
+
+// Returns word in the case it has in a sentence, where first reports whether it's the first word of the sentence.
+func caseWord(word string, first bool) string {
+	r, size := utf8.DecodeRuneInString(word)
+
+	switch {
+	case isAcronym(word):
+		return word
+	case first:
+		return string(unicode.ToUpper(r)) + word[size:]
+	default:
+		return strings.ToLower(word)
+	}
+}
+
+// Reports whether word consists of more than one uppercase letter.
+func isAcronym(word string) bool {
+	return utf8.RuneCountInString(word) > 1 && strings.ToUpper(word) == word && strings.ToLower(word) != word
+}
+
+// Returns words without the words that equal the word before them, ignoring case.
+func collapseDuplicates(words []string) []string {
+	res := words[:0]
+
+	for idx, word := range words {
+		if idx == 0 || !strings.EqualFold(word, words[idx-1]) {
+			res = append(res, word)
+		}
+	}
+
+	return res
+}
+
+// Replaces each article in words by the one that agrees with the word after it.
+func fixArticles(words []string) {
+	for idx := 0; idx < len(words)-1; idx++ {
+		article := strings.ToLower(words[idx])
+
+		if article != "a" && article != "an" {
+			continue
+		}
+
+		want := "a"
+
+		if startsWithVowelSound(words[idx+1]) {
+			want = "an"
+		}
+
+		if words[idx] != article {
+			// The article is the first word of the sentence.
+			want = strings.ToUpper(want[:1]) + want[1:]
+		}
+
+		words[idx] = want
+	}
+}
+
+// Words that start with a vowel but are pronounced with a consonant sound, and the other way around.
+var (
+	consonantSoundPrefixes = []string{"eu", "one", "once", "uni", "use", "usu", "uti"}
+	vowelSoundPrefixes     = []string{"honest", "honor", "honour", "hour"}
+)
+
+// Reports whether word is pronounced starting with a vowel sound.
+// Acronyms are pronounced letter by letter, so "HTTP" ("aitch") starts with a vowel sound while "URL" ("you") doesn't.
+func startsWithVowelSound(word string) bool {
+	if word == "" {
+		return false
+	}
+
+	if isAcronym(word) {
+		return strings.ContainsRune("AEFHILMNORSX", rune(word[0]))
+	}
+
+	lower := strings.ToLower(word)
+
+	for _, prefix := range vowelSoundPrefixes {
+		if strings.HasPrefix(lower, prefix) {
+			return true
+		}
+	}
+
+	for _, prefix := range consonantSoundPrefixes {
+		if strings.HasPrefix(lower, prefix) {
+			return false
+		}
+	}
+
+	return strings.ContainsRune("aeiou", rune(lower[0]))
+}
+
+// Makes each word that follows a number agree with that number.
+func fixPlurals(words []string) {
+	for idx := 0; idx < len(words)-1; idx++ {
+		if !isNumber(words[idx]) || isAcronym(words[idx+1]) || !isLowerWord(words[idx+1]) {
+			continue
+		}
+
+		if words[idx] == "1" {
+			words[idx+1] = singular(words[idx+1])
+		} else {
+			words[idx+1] = plural(words[idx+1])
+		}
+	}
+}
+
+// Reports whether word consists of digits only.
+func isNumber(word string) bool {
+	return word != "" && strings.Trim(word, "0123456789") == ""
+}
+
+// Reports whether word consists of lowercase letters only.
+func isLowerWord(word string) bool {
+	for _, r := range word {
+		if !unicode.IsLower(r) {
+			return false
+		}
+	}
+
+	return word != ""
+}
+
+// Returns the singular form of the lowercase noun word, using the regular English rules.
+// Only "s" is dropped from words ending in "ses" other than "sses", since "cases" and "responses" are far more common
+// in test names than "buses" and "statuses".
+func singular(word string) string {
+	switch {
+	case strings.HasSuffix(word, "ies") && len(word) > 3:
+		return word[:len(word)-3] + "y"
+	case strings.HasSuffix(word, "es") && hasAnySuffix(word[:len(word)-2], "ss", "x", "z", "ch", "sh"):
+		return word[:len(word)-2]
+	case strings.HasSuffix(word, "s") && !hasAnySuffix(word, "ss", "us", "is"):
+		return word[:len(word)-1]
+	default:
+		return word
+	}
+}
+
+// Returns the plural form of the lowercase noun word, using the regular English rules.
+// A word ending in "s" other than "ss" or "us" already looks plural and is returned unchanged.
+func plural(word string) string {
+	switch {
+	case strings.HasSuffix(word, "s") && !hasAnySuffix(word, "ss", "us"):
+		return word
+	case strings.HasSuffix(word, "y") && len(word) > 1 && !strings.ContainsRune("aeiou", rune(word[len(word)-2])):
+		return word[:len(word)-1] + "ies"
+	case hasSibilantSuffix(word):
+		return word + "es"
+	default:
+		return word + "s"
+	}
+}
+
+// Reports whether word ends with a sound that requires "es" in its plural form.
+func hasSibilantSuffix(word string) bool {
+	return hasAnySuffix(word, "s", "x", "z", "ch", "sh")
+}
+
+// Reports whether word ends with any of suffixes.
+func hasAnySuffix(word string, suffixes ...string) bool {
+	for _, suffix := range suffixes {
+		if strings.HasSuffix(word, suffix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Inserts a comma before the first occurrence of clause's keyword in words when words match clause.
+func insertComma(words []string, clause Clause) {
+	if len(words) == 0 || clause.Lead != "" && !strings.EqualFold(words[0], clause.Lead) {
+		return
+	}
+
+	// A trailing keyword has no condition after it, so it doesn't start a clause.
+	for idx := 1; idx < len(words)-1; idx++ {
+		if strings.EqualFold(words[idx], clause.Keyword) {
+			if !strings.HasSuffix(words[idx-1], ",") {
+				words[idx-1] += ","
+			}
+
+			return
+		}
+	}
+}
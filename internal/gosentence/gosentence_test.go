@@ -0,0 +1,113 @@
+// =====================================================================================================================
+// == LICENSE:       Copyright (c) 2024 Kevin De Coninck
+// ==
+// ==                Permission is hereby granted, free of charge, to any person
+// ==                obtaining a copy of this software and associated documentation
+// ==                files (the "Software"), to deal in the Software without
+// ==                restriction, including without limitation the rights to use,
+// ==                copy, modify, merge, publish, distribute, sublicense, and/or sell
+// ==                copies of the Software, and to permit persons to whom the
+// ==                Software is furnished to do so, subject to the following
+// ==                conditions:
+// ==
+// ==                The above copyright notice and this permission notice shall be
+// ==                included in all copies or substantial portions of the Software.
+// ==
+// ==                THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// ==                EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// ==                OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// ==                NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// ==                HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// ==                WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// ==                FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+// ==                OTHER DEALINGS IN THE SOFTWARE.
+// =====================================================================================================================
+
+package gosentence_test
+
+import (
+	"testing"
+
+	"github.com/kdeconinck/seesharp/internal/gosentence"
+)
+
+func TestConvert(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name string
+		opts gosentence.Options
+		want string
+	}{
+		{name: "", want: ""},
+		{name: "ShouldReturnHTTPError_WhenInputIsNull", want: "Should return HTTP error when input is null"},
+		{name: "shouldParse2Files", want: "Should parse 2 files"},
+		{name: "Parse.Empty-Input", want: "Parse empty input"},
+		{name: "TestTestCase", want: "Test test case"},
+		{name: "TestTestCase", opts: gosentence.Options{CollapseDuplicates: true}, want: "Test case"},
+		{name: "ReturnsAError", want: "Returns a error"},
+		{name: "ReturnsAError", opts: gosentence.Options{Articles: true}, want: "Returns an error"},
+		{name: "ReturnsAnUser", opts: gosentence.Options{Articles: true}, want: "Returns a user"},
+		{name: "ReturnsAHour", opts: gosentence.Options{Articles: true}, want: "Returns an hour"},
+		{name: "ReturnsAnURL", opts: gosentence.Options{Articles: true}, want: "Returns a URL"},
+		{name: "ReturnsA_HTTPError", opts: gosentence.Options{Articles: true}, want: "Returns an HTTP error"},
+		{name: "AnTestRuns", opts: gosentence.Options{Articles: true}, want: "A test runs"},
+		{name: "Returns1Items", opts: gosentence.Options{Pluralize: true}, want: "Returns 1 item"},
+		{name: "Returns1Entries", opts: gosentence.Options{Pluralize: true}, want: "Returns 1 entry"},
+		{name: "Returns1Matches", opts: gosentence.Options{Pluralize: true}, want: "Returns 1 match"},
+		{name: "Returns1Status", opts: gosentence.Options{Pluralize: true}, want: "Returns 1 status"},
+		{name: "Returns1Cases", opts: gosentence.Options{Pluralize: true}, want: "Returns 1 case"},
+		{name: "Returns1Responses", opts: gosentence.Options{Pluralize: true}, want: "Returns 1 response"},
+		{name: "Returns1Databases", opts: gosentence.Options{Pluralize: true}, want: "Returns 1 database"},
+		{name: "Returns1Classes", opts: gosentence.Options{Pluralize: true}, want: "Returns 1 class"},
+		{name: "Returns1Boxes", opts: gosentence.Options{Pluralize: true}, want: "Returns 1 box"},
+		{name: "Returns1Wishes", opts: gosentence.Options{Pluralize: true}, want: "Returns 1 wish"},
+		{name: "Returns2Item", opts: gosentence.Options{Pluralize: true}, want: "Returns 2 items"},
+		{name: "Returns2Status", opts: gosentence.Options{Pluralize: true}, want: "Returns 2 statuses"},
+		{name: "Returns2Class", opts: gosentence.Options{Pluralize: true}, want: "Returns 2 classes"},
+		{name: "Returns0Entry", opts: gosentence.Options{Pluralize: true}, want: "Returns 0 entries"},
+		{name: "Returns3Box", opts: gosentence.Options{Pluralize: true}, want: "Returns 3 boxes"},
+		{name: "Returns3Days", opts: gosentence.Options{Pluralize: true}, want: "Returns 3 days"},
+		{name: "Returns2URL", opts: gosentence.Options{Pluralize: true}, want: "Returns 2 URL"},
+		{
+			name: "Test_TestReturnsAError",
+			opts: gosentence.Options{CollapseDuplicates: true, Articles: true, Pluralize: true},
+			want: "Test returns an error",
+		},
+		{name: "ShouldReturnErrorWhenInputIsNull", want: "Should return error when input is null"},
+		{
+			name: "ShouldReturnErrorWhenInputIsNull",
+			opts: gosentence.Options{Clauses: gosentence.DefaultClauses},
+			want: "Should return error, when input is null",
+		},
+		{
+			name: "Returns_AError_GivenEmptyInput",
+			opts: gosentence.Options{Articles: true, Clauses: gosentence.DefaultClauses},
+			want: "Returns an error, given empty input",
+		},
+		{
+			name: "ShouldReturnNullWhen",
+			opts: gosentence.Options{Clauses: gosentence.DefaultClauses},
+			want: "Should return null when",
+		},
+		{
+			name: "ParseWhenEmpty",
+			opts: gosentence.Options{Clauses: gosentence.DefaultClauses},
+			want: "Parse when empty",
+		},
+		{
+			name: "ShouldFailWhenAWhenB",
+			opts: gosentence.Options{Clauses: gosentence.DefaultClauses},
+			want: "Should fail, when a when b",
+		},
+		{
+			name: "ParseIfEmpty",
+			opts: gosentence.Options{Clauses: []gosentence.Clause{{Keyword: "if"}}},
+			want: "Parse, if empty",
+		},
+	} {
+		if got := gosentence.Convert(tc.name, tc.opts); got != tc.want {
+			t.Errorf("Convert(%q, %+v) = %q, want %q", tc.name, tc.opts, got, tc.want)
+		}
+	}
+}
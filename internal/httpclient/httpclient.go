@@ -0,0 +1,307 @@
+// =====================================================================================================================
+// == LICENSE:       Copyright (c) 2024 Kevin De Coninck
+// ==
+// ==                Permission is hereby granted, free of charge, to any person
+// ==                obtaining a copy of this software and associated documentation
+// ==                files (the "Software"), to deal in the Software without
+// ==                restriction, including without limitation the rights to use,
+// ==                copy, modify, merge, publish, distribute, sublicense, and/or sell
+// ==                copies of the Software, and to permit persons to whom the
+// ==                Software is furnished to do so, subject to the following
+// ==                conditions:
+// ==
+// ==                The above copyright notice and this permission notice shall be
+// ==                included in all copies or substantial portions of the Software.
+// ==
+// ==                THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// ==                EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// ==                OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// ==                NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// ==                HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// ==                WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// ==                FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+// ==                OTHER DEALINGS IN THE SOFTWARE.
+// =====================================================================================================================
+
+// Package httpclient provides the HTTP client shared by the integrations that talk to external services, such as
+// NuGet, GitHub, Azure DevOps and webhooks.
+//
+// A Client retries failed requests with exponential backoff, honors "Retry-After" headers, limits the rate at which
+// requests are sent, and reports failed attempts as structured warnings, so a flaky service degrades the output of a
+// command instead of aborting it.
+package httpclient
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// Policy controls how a Client retries requests and limits their rate.
+type Policy struct {
+	// MaxRetries is the number of times a failed request is retried after the first attempt.
+	MaxRetries int
+
+	// BaseDelay is the delay before the first retry. It doubles for every subsequent retry.
+	BaseDelay time.Duration
+
+	// MaxDelay caps the delay before a retry, including delays requested by a "Retry-After" header. A value of 0 doesn't
+	// cap the delay.
+	MaxDelay time.Duration
+
+	// RequestsPerSecond is the sustained rate at which requests are sent. A value of 0 disables rate limiting.
+	RequestsPerSecond float64
+
+	// Burst is the number of requests that can be sent at once before the rate limit applies. Values below 1 are
+	// treated as 1.
+	Burst int
+}
+
+// DefaultPolicy returns the policy used by integrations that don't configure their own.
+func DefaultPolicy() Policy {
+	return Policy{MaxRetries: 3, BaseDelay: 500 * time.Millisecond, MaxDelay: 30 * time.Second}
+}
+
+// A Warning describes a request attempt that failed.
+type Warning struct {
+	Integration string // The name of the integration that sent the request.
+	Method      string
+	URL         string
+	Attempt     int   // The 1-based number of the attempt that failed.
+	StatusCode  int   // The status code of the response, or 0 if no response was received.
+	Err         error // The error that occurred, if no response was received.
+	Retrying    bool  // Whether the request will be retried.
+}
+
+// String returns a human-readable description of w.
+func (w Warning) String() string {
+	reason := http.StatusText(w.StatusCode)
+
+	if w.Err != nil {
+		reason = w.Err.Error()
+	} else if reason == "" {
+		reason = "status " + strconv.Itoa(w.StatusCode)
+	}
+
+	if w.Retrying {
+		return fmt.Sprintf("%s: %s %s failed on attempt %d (%s), retrying", w.Integration, w.Method, w.URL, w.Attempt,
+			reason)
+	}
+
+	return fmt.Sprintf("%s: %s %s failed after %d attempt(s) (%s)", w.Integration, w.Method, w.URL, w.Attempt, reason)
+}
+
+// An Error is returned by Client.Do when a request failed on its last attempt.
+type Error struct {
+	Warning
+}
+
+// Error returns a human-readable description of e.
+func (e *Error) Error() string {
+	return e.Warning.String()
+}
+
+// Unwrap returns the error of the last attempt, if any.
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// A Client sends HTTP requests according to a Policy.
+// A Client is safe for concurrent use by multiple goroutines.
+type Client struct {
+	// OnWarning, if set, is called for every failed attempt, including the last one.
+	OnWarning func(Warning)
+
+	name    string
+	http    *http.Client
+	policy  Policy
+	limiter *limiter
+	sleep   func(ctx context.Context, d time.Duration) error
+}
+
+// New returns a new Client for the integration named name that sends requests through hc according to policy.
+// When hc is nil, http.DefaultClient is used, which honors the proxy environment variables but only trusts the
+// certificate authorities of the operating system. Use a client with a transport returned by NewTransport to trust
+// additional certificate authorities or to present a client certificate.
+func New(name string, hc *http.Client, policy Policy) *Client {
+	if hc == nil {
+		hc = http.DefaultClient
+	}
+
+	return &Client{
+		name:    name,
+		http:    hc,
+		policy:  policy,
+		limiter: newLimiter(policy.RequestsPerSecond, policy.Burst, time.Now),
+		sleep:   sleep,
+	}
+}
+
+// Do sends req and returns its response.
+//
+// Requests that fail with a network error or a 429, 500, 502, 503 or 504 status code are retried according to the
+// policy of c, provided their body can be sent again (see http.Request.GetBody). When the last attempt fails, the
+// returned error is an *Error. Responses with other status codes are returned as-is.
+func (c *Client) Do(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+
+	for attempt := 1; ; attempt++ {
+		if err := c.limiter.wait(ctx, c.sleep); err != nil {
+			return nil, err
+		}
+
+		attemptReq, err := rewind(req, attempt)
+
+		if err != nil {
+			return nil, err
+		}
+
+		resp, err := c.http.Do(attemptReq)
+
+		if err == nil && !retryableStatus(resp.StatusCode) {
+			return resp, nil
+		}
+
+		if ctx.Err() != nil {
+			if resp != nil {
+				resp.Body.Close()
+			}
+
+			return nil, ctx.Err()
+		}
+
+		warning := Warning{Integration: c.name, Method: req.Method, URL: req.URL.Redacted(), Attempt: attempt, Err: err}
+		delay := c.backoff(attempt)
+
+		if resp != nil {
+			warning.StatusCode = resp.StatusCode
+
+			if d, ok := retryAfter(resp.Header.Get("Retry-After"), time.Now()); ok {
+				delay = c.capDelay(d)
+			}
+
+			resp.Body.Close()
+		}
+
+		warning.Retrying = attempt <= c.policy.MaxRetries && (req.Body == nil || req.GetBody != nil)
+
+		if c.OnWarning != nil {
+			c.OnWarning(warning)
+		}
+
+		if !warning.Retrying {
+			return nil, &Error{Warning: warning}
+		}
+
+		if err := c.sleep(ctx, delay); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// Returns the request to send for the given 1-based attempt of req, with a fresh body for every retry.
+func rewind(req *http.Request, attempt int) (*http.Request, error) {
+	if attempt == 1 || req.Body == nil {
+		return req, nil
+	}
+
+	body, err := req.GetBody()
+
+	if err != nil {
+		return nil, err
+	}
+
+	clone := req.Clone(req.Context())
+	clone.Body = body
+
+	return clone, nil
+}
+
+// Reports whether a response with status code code should be retried.
+func retryableStatus(code int) bool {
+	switch code {
+	case http.StatusTooManyRequests, http.StatusInternalServerError, http.StatusBadGateway,
+		http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+
+	default:
+		return false
+	}
+}
+
+// Returns the delay before retrying the given 1-based attempt: BaseDelay doubled for every previous retry, capped at
+// MaxDelay, of which the second half is randomized to spread the retries of concurrent clients.
+func (c *Client) backoff(attempt int) time.Duration {
+	delay := c.policy.BaseDelay
+
+	for range attempt - 1 {
+		if delay > math.MaxInt64/2 {
+			// Doubling would overflow, which only happens without a MaxDelay.
+			delay = math.MaxInt64
+
+			break
+		}
+
+		if delay *= 2; c.policy.MaxDelay > 0 && delay >= c.policy.MaxDelay {
+			break
+		}
+	}
+
+	if delay = c.capDelay(delay); delay <= 1 {
+		return delay
+	}
+
+	return delay/2 + rand.N(delay/2)
+}
+
+// Returns d capped at MaxDelay. A MaxDelay of 0 doesn't cap anything.
+func (c *Client) capDelay(d time.Duration) time.Duration {
+	if c.policy.MaxDelay > 0 {
+		return min(d, c.policy.MaxDelay)
+	}
+
+	return d
+}
+
+// Returns the delay requested by a "Retry-After" header with value v, which is either a number of seconds or an HTTP
+// date, relative to now.
+func retryAfter(v string, now time.Time) (time.Duration, bool) {
+	if v == "" {
+		return 0, false
+	}
+
+	if secs, err := strconv.Atoi(v); err == nil {
+		if int64(secs) > math.MaxInt64/int64(time.Second) {
+			return math.MaxInt64, true
+		}
+
+		return time.Duration(max(secs, 0)) * time.Second, true
+	}
+
+	if t, err := http.ParseTime(v); err == nil {
+		return max(t.Sub(now), 0), true
+	}
+
+	return 0, false
+}
+
+// Waits for d or until ctx is done, whichever happens first.
+func sleep(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,346 @@
+// =====================================================================================================================
+// == LICENSE:       Copyright (c) 2024 Kevin De Coninck
+// ==
+// ==                Permission is hereby granted, free of charge, to any person
+// ==                obtaining a copy of this software and associated documentation
+// ==                files (the "Software"), to deal in the Software without
+// ==                restriction, including without limitation the rights to use,
+// ==                copy, modify, merge, publish, distribute, sublicense, and/or sell
+// ==                copies of the Software, and to permit persons to whom the
+// ==                Software is furnished to do so, subject to the following
+// ==                conditions:
+// ==
+// ==                The above copyright notice and this permission notice shall be
+// ==                included in all copies or substantial portions of the Software.
+// ==
+// ==                THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// ==                EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// ==                OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// ==                NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// ==                HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// ==                WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// ==                FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+// ==                OTHER DEALINGS IN THE SOFTWARE.
+// =====================================================================================================================
+
+package httpclient
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// Returns a Client that records the delays it sleeps instead of sleeping, and the warnings it reports.
+func newTestClient(policy Policy) (*Client, *[]time.Duration, *[]Warning) {
+	var (
+		delays   []time.Duration
+		warnings []Warning
+	)
+
+	c := New("test", nil, policy)
+	c.OnWarning = func(w Warning) { warnings = append(warnings, w) }
+	c.sleep = func(ctx context.Context, d time.Duration) error {
+		if d > 0 {
+			delays = append(delays, d)
+		}
+
+		return ctx.Err()
+	}
+
+	return c, &delays, &warnings
+}
+
+// Returns a server that responds with the given status codes in order, repeating the last one, and the number of
+// requests it received.
+func newStatusServer(t *testing.T, codes ...int) (*httptest.Server, *atomic.Int32) {
+	t.Helper()
+
+	var count atomic.Int32
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		idx := int(count.Add(1)) - 1
+		w.WriteHeader(codes[min(idx, len(codes)-1)])
+	}))
+
+	t.Cleanup(srv.Close)
+
+	return srv, &count
+}
+
+func TestDoRetriesUntilSuccess(t *testing.T) {
+	t.Parallel()
+
+	srv, count := newStatusServer(t, http.StatusServiceUnavailable, http.StatusTooManyRequests, http.StatusOK)
+	c, delays, warnings := newTestClient(Policy{MaxRetries: 3, BaseDelay: 100 * time.Millisecond, MaxDelay: time.Second})
+	req, _ := http.NewRequest(http.MethodGet, srv.URL, nil)
+	resp, err := c.Do(req)
+
+	if err != nil || resp.StatusCode != http.StatusOK {
+		t.Fatalf("Do() = %v, %v, want 200 OK", resp, err)
+	}
+
+	resp.Body.Close()
+
+	if count.Load() != 3 || len(*delays) != 2 || len(*warnings) != 2 {
+		t.Errorf("got %d attempts, delays %v, warnings %v, want 3 attempts and 2 retries", count.Load(), *delays,
+			*warnings)
+	}
+
+	for idx, w := range *warnings {
+		if !w.Retrying || w.Attempt != idx+1 || w.Integration != "test" || w.Method != http.MethodGet {
+			t.Errorf("warning %d = %+v, want a retrying warning for attempt %d", idx, w, idx+1)
+		}
+	}
+}
+
+func TestDoGivesUp(t *testing.T) {
+	t.Parallel()
+
+	srv, count := newStatusServer(t, http.StatusBadGateway)
+	c, _, warnings := newTestClient(Policy{MaxRetries: 2, BaseDelay: time.Millisecond})
+	req, _ := http.NewRequest(http.MethodGet, srv.URL, nil)
+	resp, err := c.Do(req)
+
+	var httpErr *Error
+
+	if !errors.As(err, &httpErr) || resp != nil {
+		t.Fatalf("Do() = %v, %v, want an *Error", resp, err)
+	}
+
+	if httpErr.Attempt != 3 || httpErr.StatusCode != http.StatusBadGateway || httpErr.Retrying || count.Load() != 3 {
+		t.Errorf("Do() error = %+v after %d requests, want 3 attempts ending in 502", httpErr.Warning, count.Load())
+	}
+
+	if len(*warnings) != 3 || (*warnings)[2] != httpErr.Warning {
+		t.Errorf("warnings = %+v, want 3 ending with the returned error", *warnings)
+	}
+
+	if want := "test: GET " + srv.URL + " failed after 3 attempt(s) (Bad Gateway)"; err.Error() != want {
+		t.Errorf("Error() = %q, want %q", err.Error(), want)
+	}
+}
+
+func TestDoDoesNotRetryOtherStatusCodes(t *testing.T) {
+	t.Parallel()
+
+	srv, count := newStatusServer(t, http.StatusNotFound)
+	c, _, warnings := newTestClient(DefaultPolicy())
+	req, _ := http.NewRequest(http.MethodGet, srv.URL, nil)
+	resp, err := c.Do(req)
+
+	if err != nil || resp.StatusCode != http.StatusNotFound || count.Load() != 1 || len(*warnings) != 0 {
+		t.Fatalf("Do() = %v, %v after %d requests, want a single 404", resp, err, count.Load())
+	}
+
+	resp.Body.Close()
+}
+
+func TestDoHonorsRetryAfter(t *testing.T) {
+	t.Parallel()
+
+	var count atomic.Int32
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		switch count.Add(1) {
+		case 1:
+			w.Header().Set("Retry-After", "2")
+			w.WriteHeader(http.StatusTooManyRequests)
+		case 2:
+			w.Header().Set("Retry-After", "120")
+			w.WriteHeader(http.StatusServiceUnavailable)
+		default:
+			w.WriteHeader(http.StatusOK)
+		}
+	}))
+
+	defer srv.Close()
+
+	c, delays, _ := newTestClient(Policy{MaxRetries: 3, BaseDelay: time.Millisecond, MaxDelay: 10 * time.Second})
+	req, _ := http.NewRequest(http.MethodGet, srv.URL, nil)
+	resp, err := c.Do(req)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resp.Body.Close()
+
+	if want := []time.Duration{2 * time.Second, 10 * time.Second}; !slices.Equal(*delays, want) {
+		t.Errorf("delays = %v, want %v", *delays, want)
+	}
+}
+
+func TestDoHonorsRetryAfterWithoutMaxDelay(t *testing.T) {
+	t.Parallel()
+
+	var count atomic.Int32
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		if count.Add(1) == 1 {
+			w.Header().Set("Retry-After", "2")
+			w.WriteHeader(http.StatusTooManyRequests)
+		}
+	}))
+
+	defer srv.Close()
+
+	c, delays, _ := newTestClient(Policy{MaxRetries: 2, BaseDelay: 10 * time.Millisecond})
+	req, _ := http.NewRequest(http.MethodGet, srv.URL, nil)
+	resp, err := c.Do(req)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resp.Body.Close()
+
+	if want := []time.Duration{2 * time.Second}; !slices.Equal(*delays, want) {
+		t.Errorf("delays = %v, want %v", *delays, want)
+	}
+}
+
+func TestDoReplaysBody(t *testing.T) {
+	t.Parallel()
+
+	var bodies []string
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		data, _ := io.ReadAll(r.Body)
+
+		if bodies = append(bodies, string(data)); len(bodies) < 3 {
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+
+	defer srv.Close()
+
+	c, _, _ := newTestClient(Policy{MaxRetries: 3})
+	req, _ := http.NewRequest(http.MethodPost, srv.URL, strings.NewReader(`{"text":"hi"}`))
+	resp, err := c.Do(req)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resp.Body.Close()
+
+	if want := []string{`{"text":"hi"}`, `{"text":"hi"}`, `{"text":"hi"}`}; !slices.Equal(bodies, want) {
+		t.Errorf("bodies = %q, want %q", bodies, want)
+	}
+}
+
+func TestDoDoesNotRetryUnreplayableBody(t *testing.T) {
+	t.Parallel()
+
+	srv, count := newStatusServer(t, http.StatusServiceUnavailable)
+	c, _, _ := newTestClient(Policy{MaxRetries: 3})
+	req, _ := http.NewRequest(http.MethodPost, srv.URL, io.NopCloser(strings.NewReader("data")))
+	_, err := c.Do(req)
+
+	var httpErr *Error
+
+	if !errors.As(err, &httpErr) || count.Load() != 1 {
+		t.Errorf("Do() = %v after %d requests, want an *Error after a single request", err, count.Load())
+	}
+}
+
+func TestDoNetworkError(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.NotFoundHandler())
+	srv.Close()
+
+	c, _, warnings := newTestClient(Policy{MaxRetries: 1})
+	req, _ := http.NewRequest(http.MethodGet, srv.URL, nil)
+	_, err := c.Do(req)
+
+	var httpErr *Error
+
+	if !errors.As(err, &httpErr) || httpErr.Err == nil || errors.Unwrap(err) != httpErr.Err || len(*warnings) != 2 {
+		t.Errorf("Do() = %v with warnings %v, want an *Error wrapping the network error after 2 attempts", err,
+			*warnings)
+	}
+}
+
+func TestDoStopsWhenContextIsDone(t *testing.T) {
+	t.Parallel()
+
+	srv, count := newStatusServer(t, http.StatusServiceUnavailable)
+	ctx, cancel := context.WithCancel(context.Background())
+	c := New("test", nil, Policy{MaxRetries: 5, BaseDelay: time.Hour, MaxDelay: time.Hour})
+	c.OnWarning = func(Warning) { cancel() }
+
+	req, _ := http.NewRequestWithContext(ctx, http.MethodGet, srv.URL, nil)
+
+	if _, err := c.Do(req); !errors.Is(err, context.Canceled) || count.Load() != 1 {
+		t.Errorf("Do() = %v after %d requests, want %v after a single request", err, count.Load(), context.Canceled)
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	t.Parallel()
+
+	c := New("test", nil, Policy{BaseDelay: 100 * time.Millisecond, MaxDelay: time.Second})
+
+	for _, tc := range []struct {
+		attempt int
+		max     time.Duration
+	}{
+		{attempt: 1, max: 100 * time.Millisecond},
+		{attempt: 2, max: 200 * time.Millisecond},
+		{attempt: 4, max: 800 * time.Millisecond},
+		{attempt: 5, max: time.Second},
+		{attempt: 100, max: time.Second},
+	} {
+		for range 20 {
+			if got := c.backoff(tc.attempt); got < tc.max/2 || got > tc.max {
+				t.Errorf("backoff(%d) = %v, want between %v and %v", tc.attempt, got, tc.max/2, tc.max)
+			}
+		}
+	}
+}
+
+func TestBackoffWithoutMaxDelay(t *testing.T) {
+	t.Parallel()
+
+	c := New("test", nil, Policy{BaseDelay: time.Second})
+
+	for _, attempt := range []int{1, 10, 40, 100, 1000} {
+		if got := c.backoff(attempt); got < time.Second/2 {
+			t.Errorf("backoff(%d) = %v, want at least %v", attempt, got, time.Second/2)
+		}
+	}
+}
+
+func TestRetryAfter(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 1, 6, 12, 0, 0, 0, time.UTC)
+
+	for _, tc := range []struct {
+		value string
+		want  time.Duration
+		ok    bool
+	}{
+		{value: "", ok: false},
+		{value: "3", want: 3 * time.Second, ok: true},
+		{value: "-3", want: 0, ok: true},
+		{value: "Sat, 06 Jan 2024 12:00:30 GMT", want: 30 * time.Second, ok: true},
+		{value: "Sat, 06 Jan 2024 11:00:00 GMT", want: 0, ok: true},
+		{value: "soon", ok: false},
+		{value: "99999999999999999", want: math.MaxInt64, ok: true},
+	} {
+		if got, ok := retryAfter(tc.value, now); got != tc.want || ok != tc.ok {
+			t.Errorf("retryAfter(%q) = %v, %t, want %v, %t", tc.value, got, ok, tc.want, tc.ok)
+		}
+	}
+}
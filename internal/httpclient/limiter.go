@@ -0,0 +1,80 @@
+// =====================================================================================================================
+// == LICENSE:       Copyright (c) 2024 Kevin De Coninck
+// ==
+// ==                Permission is hereby granted, free of charge, to any person
+// ==                obtaining a copy of this software and associated documentation
+// ==                files (the "Software"), to deal in the Software without
+// ==                restriction, including without limitation the rights to use,
+// ==                copy, modify, merge, publish, distribute, sublicense, and/or sell
+// ==                copies of the Software, and to permit persons to whom the
+// ==                Software is furnished to do so, subject to the following
+// ==                conditions:
+// ==
+// ==                The above copyright notice and this permission notice shall be
+// ==                included in all copies or substantial portions of the Software.
+// ==
+// ==                THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// ==                EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// ==                OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// ==                NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// ==                HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// ==                WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// ==                FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+// ==                OTHER DEALINGS IN THE SOFTWARE.
+// =====================================================================================================================
+
+package httpclient
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// A limiter is a token bucket that limits the rate at which requests are sent.
+// A nil limiter doesn't limit anything.
+type limiter struct {
+	mu     sync.Mutex
+	rate   float64 // The number of tokens added per second.
+	burst  float64 // The maximum number of tokens in the bucket.
+	tokens float64 // The number of tokens in the bucket; negative when requests are waiting for tokens.
+	last   time.Time
+	now    func() time.Time
+}
+
+// Returns a new limiter that allows rate requests per second with bursts of up to burst requests, or nil when rate
+// isn't positive.
+func newLimiter(rate float64, burst int, now func() time.Time) *limiter {
+	if rate <= 0 {
+		return nil
+	}
+
+	b := float64(max(burst, 1))
+
+	return &limiter{rate: rate, burst: b, tokens: b, last: now(), now: now}
+}
+
+// Takes a token from the bucket, using sleep to wait until the token is available.
+// A token taken by a wait that is interrupted by ctx is not returned to the bucket.
+func (l *limiter) wait(ctx context.Context, sleep func(context.Context, time.Duration) error) error {
+	if l == nil {
+		return ctx.Err()
+	}
+
+	l.mu.Lock()
+
+	now := l.now()
+	l.tokens = min(l.burst, l.tokens+now.Sub(l.last).Seconds()*l.rate)
+	l.last = now
+	l.tokens--
+
+	var delay time.Duration
+
+	if l.tokens < 0 {
+		delay = time.Duration(-l.tokens / l.rate * float64(time.Second))
+	}
+
+	l.mu.Unlock()
+
+	return sleep(ctx, delay)
+}
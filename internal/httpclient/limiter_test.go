@@ -0,0 +1,79 @@
+// =====================================================================================================================
+// == LICENSE:       Copyright (c) 2024 Kevin De Coninck
+// ==
+// ==                Permission is hereby granted, free of charge, to any person
+// ==                obtaining a copy of this software and associated documentation
+// ==                files (the "Software"), to deal in the Software without
+// ==                restriction, including without limitation the rights to use,
+// ==                copy, modify, merge, publish, distribute, sublicense, and/or sell
+// ==                copies of the Software, and to permit persons to whom the
+// ==                Software is furnished to do so, subject to the following
+// ==                conditions:
+// ==
+// ==                The above copyright notice and this permission notice shall be
+// ==                included in all copies or substantial portions of the Software.
+// ==
+// ==                THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// ==                EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// ==                OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// ==                NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// ==                HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// ==                WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// ==                FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+// ==                OTHER DEALINGS IN THE SOFTWARE.
+// =====================================================================================================================
+
+package httpclient
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestLimiter(t *testing.T) {
+	t.Parallel()
+
+	var (
+		now    = time.Date(2024, 1, 6, 12, 0, 0, 0, time.UTC)
+		delays []time.Duration
+		l      = newLimiter(2, 2, func() time.Time { return now })
+	)
+
+	sleep := func(_ context.Context, d time.Duration) error {
+		delays = append(delays, d)
+
+		return nil
+	}
+
+	for range 4 {
+		_ = l.wait(context.Background(), sleep)
+	}
+
+	now = now.Add(3 * time.Second)
+	_ = l.wait(context.Background(), sleep)
+
+	want := []time.Duration{0, 0, 500 * time.Millisecond, time.Second, 0}
+
+	if !slices.Equal(delays, want) {
+		t.Errorf("delays = %v, want %v", delays, want)
+	}
+}
+
+func TestLimiterDisabled(t *testing.T) {
+	t.Parallel()
+
+	if l := newLimiter(0, 10, time.Now); l != nil {
+		t.Fatalf("newLimiter(0, 10) = %+v, want nil", l)
+	}
+
+	var l *limiter
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if err := l.wait(ctx, sleep); err != context.Canceled {
+		t.Errorf("wait() on a nil limiter = %v, want %v", err, context.Canceled)
+	}
+}
@@ -0,0 +1,94 @@
+// =====================================================================================================================
+// == LICENSE:       Copyright (c) 2024 Kevin De Coninck
+// ==
+// ==                Permission is hereby granted, free of charge, to any person
+// ==                obtaining a copy of this software and associated documentation
+// ==                files (the "Software"), to deal in the Software without
+// ==                restriction, including without limitation the rights to use,
+// ==                copy, modify, merge, publish, distribute, sublicense, and/or sell
+// ==                copies of the Software, and to permit persons to whom the
+// ==                Software is furnished to do so, subject to the following
+// ==                conditions:
+// ==
+// ==                The above copyright notice and this permission notice shall be
+// ==                included in all copies or substantial portions of the Software.
+// ==
+// ==                THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// ==                EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// ==                OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// ==                NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// ==                HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// ==                WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// ==                FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+// ==                OTHER DEALINGS IN THE SOFTWARE.
+// =====================================================================================================================
+
+package httpclient
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+)
+
+// TransportOptions configures the TLS settings of the transport used by the integrations, for networks that intercept
+// TLS traffic or require clients to authenticate with a certificate.
+type TransportOptions struct {
+	// CAFiles are PEM files with the certificates of the certificate authorities that are trusted in addition to those
+	// of the operating system.
+	CAFiles []string
+
+	// CertFile and KeyFile are the PEM files with the client certificate and its private key. Either both or neither
+	// must be set.
+	CertFile string
+	KeyFile  string
+}
+
+// NewTransport returns a transport that honors the HTTP_PROXY, HTTPS_PROXY and NO_PROXY environment variables (or their
+// lowercase versions), and that uses the certificates in opts.
+func NewTransport(opts TransportOptions) (*http.Transport, error) {
+	tr := http.DefaultTransport.(*http.Transport).Clone()
+	tr.Proxy = http.ProxyFromEnvironment
+	tr.TLSClientConfig = &tls.Config{MinVersion: tls.VersionTLS12}
+
+	if len(opts.CAFiles) > 0 {
+		pool, err := x509.SystemCertPool()
+
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+
+		for _, name := range opts.CAFiles {
+			data, err := os.ReadFile(name)
+
+			if err != nil {
+				return nil, fmt.Errorf("httpclient: %w", err)
+			}
+
+			if !pool.AppendCertsFromPEM(data) {
+				return nil, fmt.Errorf("httpclient: %s: no PEM certificates found", name)
+			}
+		}
+
+		tr.TLSClientConfig.RootCAs = pool
+	}
+
+	if (opts.CertFile == "") != (opts.KeyFile == "") {
+		return nil, errors.New("httpclient: a client certificate requires both a certificate and a key file")
+	}
+
+	if opts.CertFile != "" {
+		cert, err := tls.LoadX509KeyPair(opts.CertFile, opts.KeyFile)
+
+		if err != nil {
+			return nil, fmt.Errorf("httpclient: client certificate: %w", err)
+		}
+
+		tr.TLSClientConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	return tr, nil
+}
@@ -0,0 +1,208 @@
+// =====================================================================================================================
+// == LICENSE:       Copyright (c) 2024 Kevin De Coninck
+// ==
+// ==                Permission is hereby granted, free of charge, to any person
+// ==                obtaining a copy of this software and associated documentation
+// ==                files (the "Software"), to deal in the Software without
+// ==                restriction, including without limitation the rights to use,
+// ==                copy, modify, merge, publish, distribute, sublicense, and/or sell
+// ==                copies of the Software, and to permit persons to whom the
+// ==                Software is furnished to do so, subject to the following
+// ==                conditions:
+// ==
+// ==                The above copyright notice and this permission notice shall be
+// ==                included in all copies or substantial portions of the Software.
+// ==
+// ==                THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// ==                EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// ==                OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// ==                NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// ==                HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// ==                WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// ==                FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+// ==                OTHER DEALINGS IN THE SOFTWARE.
+// =====================================================================================================================
+
+package httpclient
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+)
+
+// Writes the given PEM blocks to a new file in dir and returns its name.
+func writePEM(t *testing.T, dir, name string, blocks ...*pem.Block) string {
+	t.Helper()
+
+	var data []byte
+
+	for _, b := range blocks {
+		data = append(data, pem.EncodeToMemory(b)...)
+	}
+
+	name = filepath.Join(dir, name)
+
+	if err := os.WriteFile(name, data, 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	return name
+}
+
+// Generates a self-signed client certificate and returns the names of the PEM files with the certificate and its key.
+func writeClientCert(t *testing.T, dir string) (string, string) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	keyDER, err := x509.MarshalECPrivateKey(key)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return writePEM(t, dir, "client.crt", &pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		writePEM(t, dir, "client.key", &pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+}
+
+// Sends a GET request to url through tr and returns the error, if any.
+func get(tr *http.Transport, url string) error {
+	resp, err := (&http.Client{Transport: tr}).Get(url)
+
+	if err == nil {
+		resp.Body.Close()
+	}
+
+	return err
+}
+
+func TestNewTransportUsesProxyFromEnvironment(t *testing.T) {
+	t.Parallel()
+
+	tr, err := NewTransport(TransportOptions{})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if reflect.ValueOf(tr.Proxy).Pointer() != reflect.ValueOf(http.ProxyFromEnvironment).Pointer() {
+		t.Error("NewTransport().Proxy isn't http.ProxyFromEnvironment")
+	}
+}
+
+func TestNewTransportTrustsCAFiles(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewTLSServer(http.NotFoundHandler())
+	defer srv.Close()
+
+	dir := t.TempDir()
+	ca := writePEM(t, dir, "ca.pem", &pem.Block{Type: "CERTIFICATE", Bytes: srv.Certificate().Raw})
+
+	untrusted, _ := NewTransport(TransportOptions{})
+
+	if err := get(untrusted, srv.URL); err == nil {
+		t.Error("GET without the CA file succeeded, want a certificate error")
+	}
+
+	trusted, err := NewTransport(TransportOptions{CAFiles: []string{ca}})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := get(trusted, srv.URL); err != nil {
+		t.Errorf("GET with the CA file = %v, want <nil>", err)
+	}
+}
+
+func TestNewTransportPresentsClientCert(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewUnstartedServer(http.NotFoundHandler())
+	srv.TLS = &tls.Config{ClientAuth: tls.RequireAnyClientCert}
+	srv.StartTLS()
+
+	defer srv.Close()
+
+	dir := t.TempDir()
+	ca := writePEM(t, dir, "ca.pem", &pem.Block{Type: "CERTIFICATE", Bytes: srv.Certificate().Raw})
+	cert, key := writeClientCert(t, dir)
+
+	withoutCert, _ := NewTransport(TransportOptions{CAFiles: []string{ca}})
+
+	if err := get(withoutCert, srv.URL); err == nil {
+		t.Error("GET without a client certificate succeeded, want a handshake error")
+	}
+
+	withCert, err := NewTransport(TransportOptions{CAFiles: []string{ca}, CertFile: cert, KeyFile: key})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := get(withCert, srv.URL); err != nil {
+		t.Errorf("GET with a client certificate = %v, want <nil>", err)
+	}
+}
+
+func TestNewTransportInvalidOptions(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	notPEM := filepath.Join(dir, "ca.txt")
+
+	if err := os.WriteFile(notPEM, []byte("not a certificate"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	cert, _ := writeClientCert(t, dir)
+
+	for _, tc := range []struct {
+		name string
+		opts TransportOptions
+	}{
+		{name: "MissingCAFile", opts: TransportOptions{CAFiles: []string{filepath.Join(dir, "missing.pem")}}},
+		{name: "InvalidCAFile", opts: TransportOptions{CAFiles: []string{notPEM}}},
+		{name: "CertWithoutKey", opts: TransportOptions{CertFile: cert}},
+		{name: "KeyWithoutCert", opts: TransportOptions{KeyFile: cert}},
+		{name: "InvalidKey", opts: TransportOptions{CertFile: cert, KeyFile: notPEM}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			if tr, err := NewTransport(tc.opts); err == nil {
+				t.Errorf("NewTransport(%+v) = %v, <nil>, want an error", tc.opts, tr)
+			}
+		})
+	}
+}
@@ -0,0 +1,296 @@
+// =====================================================================================================================
+// == LICENSE:       Copyright (c) 2024 Kevin De Coninck
+// ==
+// ==                Permission is hereby granted, free of charge, to any person
+// ==                obtaining a copy of this software and associated documentation
+// ==                files (the "Software"), to deal in the Software without
+// ==                restriction, including without limitation the rights to use,
+// ==                copy, modify, merge, publish, distribute, sublicense, and/or sell
+// ==                copies of the Software, and to permit persons to whom the
+// ==                Software is furnished to do so, subject to the following
+// ==                conditions:
+// ==
+// ==                The above copyright notice and this permission notice shall be
+// ==                included in all copies or substantial portions of the Software.
+// ==
+// ==                THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// ==                EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// ==                OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// ==                NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// ==                HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// ==                WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// ==                FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+// ==                OTHER DEALINGS IN THE SOFTWARE.
+// =====================================================================================================================
+
+// Package junit reads test results in the JUnit XML format, which is produced by test runners for many languages, such
+// as Maven Surefire, pytest, Jest and go-junit-report.
+//
+// Both a "testsuites" root element and a single "testsuite" root element are supported. Durations are read from "time"
+// attributes, which hold a number of seconds.
+package junit
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/kdeconinck/seesharp/internal/results"
+)
+
+// Matches a number whose integer part groups its digits by three with commas, such as "1,234.5".
+var thousandsPattern = regexp.MustCompile(`^\d{1,3}(,\d{3})+(\.\d*)?$`)
+
+// Load reads a JUnit XML document from r and converts it into a results.Run.
+func Load(r io.Reader) (results.Run, error) {
+	dec := xml.NewDecoder(r)
+
+	for {
+		tok, err := dec.Token()
+
+		if err == io.EOF {
+			return results.Run{}, fmt.Errorf("junit: no root element")
+		}
+
+		if err != nil {
+			return results.Run{}, fmt.Errorf("junit: %w", err)
+		}
+
+		start, ok := tok.(xml.StartElement)
+
+		if !ok {
+			continue
+		}
+
+		switch start.Name.Local {
+		case "testsuites":
+			var doc xmlSuites
+
+			if err := dec.DecodeElement(&doc, &start); err != nil {
+				return results.Run{}, fmt.Errorf("junit: %w", err)
+			}
+
+			suites, err := convertSuites(doc.Suites)
+
+			if err != nil {
+				return results.Run{}, err
+			}
+
+			return results.Run{Name: doc.Name, Suites: suites}, nil
+
+		case "testsuite":
+			var doc xmlSuite
+
+			if err := dec.DecodeElement(&doc, &start); err != nil {
+				return results.Run{}, fmt.Errorf("junit: %w", err)
+			}
+
+			suites, err := convertSuites([]xmlSuite{doc})
+
+			if err != nil {
+				return results.Run{}, err
+			}
+
+			return results.Run{Name: doc.Name, Suites: suites}, nil
+
+		default:
+			return results.Run{}, fmt.Errorf("junit: unexpected root element <%s>, want <testsuites> or <testsuite>",
+				start.Name.Local)
+		}
+	}
+}
+
+// LoadFile reads the JUnit XML document in the file name and converts it into a results.Run.
+func LoadFile(name string) (results.Run, error) {
+	f, err := os.Open(name)
+
+	if err != nil {
+		return results.Run{}, err
+	}
+
+	defer f.Close()
+
+	run, err := Load(f)
+
+	if err != nil {
+		return results.Run{}, fmt.Errorf("%s: %w", name, err)
+	}
+
+	return run, nil
+}
+
+// An xmlSuites is a "testsuites" element.
+type xmlSuites struct {
+	Name   string     `xml:"name,attr"`
+	Suites []xmlSuite `xml:"testsuite"`
+}
+
+// An xmlSuite is a "testsuite" element.
+type xmlSuite struct {
+	Name      string     `xml:"name,attr"`
+	Timestamp string     `xml:"timestamp,attr"`
+	Time      string     `xml:"time,attr"`
+	Suites    []xmlSuite `xml:"testsuite"`
+	Cases     []xmlCase  `xml:"testcase"`
+	SystemOut string     `xml:"system-out"`
+	SystemErr string     `xml:"system-err"`
+}
+
+// An xmlCase is a "testcase" element.
+type xmlCase struct {
+	Name      string      `xml:"name,attr"`
+	ClassName string      `xml:"classname,attr"`
+	Time      string      `xml:"time,attr"`
+	Failure   *xmlProblem `xml:"failure"`
+	Error     *xmlProblem `xml:"error"`
+	Skipped   *xmlProblem `xml:"skipped"`
+	SystemOut string      `xml:"system-out"`
+	SystemErr string      `xml:"system-err"`
+}
+
+// An xmlProblem is a "failure", "error" or "skipped" element.
+type xmlProblem struct {
+	Message string `xml:"message,attr"`
+	Text    string `xml:",chardata"`
+}
+
+// Returns the results.Suite for each suite in suites.
+func convertSuites(suites []xmlSuite) ([]results.Suite, error) {
+	var res []results.Suite
+
+	for _, s := range suites {
+		suite, err := convertSuite(s)
+
+		if err != nil {
+			return nil, err
+		}
+
+		res = append(res, suite)
+	}
+
+	return res, nil
+}
+
+// Returns the results.Suite for s.
+func convertSuite(s xmlSuite) (results.Suite, error) {
+	d, err := parseTime(s.Time)
+
+	if err != nil {
+		return results.Suite{}, fmt.Errorf("junit: testsuite %q: %w", s.Name, err)
+	}
+
+	ts, err := parseTimestamp(s.Timestamp)
+
+	if err != nil {
+		return results.Suite{}, fmt.Errorf("junit: testsuite %q: %w", s.Name, err)
+	}
+
+	nested, err := convertSuites(s.Suites)
+
+	if err != nil {
+		return results.Suite{}, err
+	}
+
+	suite := results.Suite{
+		Name:        s.Name,
+		Timestamp:   ts,
+		Duration:    d,
+		Suites:      nested,
+		Output:      strings.TrimSpace(s.SystemOut),
+		ErrorOutput: strings.TrimSpace(s.SystemErr),
+	}
+
+	for _, c := range s.Cases {
+		test, err := convertCase(c)
+
+		if err != nil {
+			return results.Suite{}, fmt.Errorf("junit: testsuite %q: %w", s.Name, err)
+		}
+
+		suite.Tests = append(suite.Tests, test)
+	}
+
+	return suite, nil
+}
+
+// Returns the results.Test for c.
+// A test with an "error" element errored, even if it also has a "failure" element.
+func convertCase(c xmlCase) (results.Test, error) {
+	d, err := parseTime(c.Time)
+
+	if err != nil {
+		return results.Test{}, fmt.Errorf("testcase %q: %w", c.Name, err)
+	}
+
+	test := results.Test{
+		Name:        c.Name,
+		ClassName:   c.ClassName,
+		Outcome:     results.Passed,
+		Duration:    d,
+		Output:      strings.TrimSpace(c.SystemOut),
+		ErrorOutput: strings.TrimSpace(c.SystemErr),
+	}
+
+	var problem *xmlProblem
+
+	switch {
+	case c.Error != nil:
+		test.Outcome, problem = results.Errored, c.Error
+	case c.Failure != nil:
+		test.Outcome, problem = results.Failed, c.Failure
+	case c.Skipped != nil:
+		test.Outcome, problem = results.Skipped, c.Skipped
+	}
+
+	if problem != nil {
+		test.Message, test.Details = problem.Message, strings.TrimSpace(problem.Text)
+	}
+
+	return test, nil
+}
+
+// Returns the duration described by v, a number of seconds. Some runners group the digits of large numbers with
+// commas, such as "1,234.5", which are ignored. An empty v is a duration of 0.
+func parseTime(v string) (time.Duration, error) {
+	v = strings.TrimSpace(v)
+
+	if v == "" {
+		return 0, nil
+	}
+
+	digits := v
+
+	if thousandsPattern.MatchString(v) {
+		digits = strings.ReplaceAll(v, ",", "")
+	}
+
+	secs, err := strconv.ParseFloat(digits, 64)
+
+	if err != nil || math.IsNaN(secs) || secs < 0 || secs >= math.MaxInt64/float64(time.Second) {
+		return 0, fmt.Errorf("invalid time %q", v)
+	}
+
+	return time.Duration(secs * float64(time.Second)).Round(time.Microsecond), nil
+}
+
+// Returns the time described by v, in ISO 8601 format with or without a time zone, which defaults to UTC. An empty v
+// is the zero time.
+func parseTimestamp(v string) (time.Time, error) {
+	if v = strings.TrimSpace(v); v == "" {
+		return time.Time{}, nil
+	}
+
+	for _, layout := range []string{time.RFC3339Nano, "2006-01-02T15:04:05.999999999"} {
+		if ts, err := time.Parse(layout, v); err == nil {
+			return ts, nil
+		}
+	}
+
+	return time.Time{}, fmt.Errorf("invalid timestamp %q", v)
+}
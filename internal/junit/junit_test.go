@@ -0,0 +1,286 @@
+// =====================================================================================================================
+// == LICENSE:       Copyright (c) 2024 Kevin De Coninck
+// ==
+// ==                Permission is hereby granted, free of charge, to any person
+// ==                obtaining a copy of this software and associated documentation
+// ==                files (the "Software"), to deal in the Software without
+// ==                restriction, including without limitation the rights to use,
+// ==                copy, modify, merge, publish, distribute, sublicense, and/or sell
+// ==                copies of the Software, and to permit persons to whom the
+// ==                Software is furnished to do so, subject to the following
+// ==                conditions:
+// ==
+// ==                The above copyright notice and this permission notice shall be
+// ==                included in all copies or substantial portions of the Software.
+// ==
+// ==                THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// ==                EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// ==                OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// ==                NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// ==                HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// ==                WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// ==                FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+// ==                OTHER DEALINGS IN THE SOFTWARE.
+// =====================================================================================================================
+
+package junit_test
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kdeconinck/seesharp/internal/junit"
+	"github.com/kdeconinck/seesharp/internal/results"
+)
+
+const testsuitesXML = `<?xml version="1.0" encoding="UTF-8"?>
+<testsuites name="all">
+  <testsuite name="math" timestamp="2024-01-06T12:00:00" time="1.5">
+    <testcase name="TestAdd" classname="math.Calc" time="0.25"/>
+    <testcase name="TestDivide" classname="math.Calc" time="0.5">
+      <failure message="expected 2, got 3" type="AssertionError">at math_test.py:12</failure>
+      <system-out>dividing</system-out>
+    </testcase>
+    <testcase name="TestSqrt" classname="math.Calc">
+      <skipped message="not on this platform"/>
+    </testcase>
+    <testcase name="TestPow" classname="math.Calc" time="0.001">
+      <error message="NullPointerException">
+        at Calc.pow(Calc.java:7)
+      </error>
+    </testcase>
+    <testsuite name="math.nested" time="1,234.5">
+      <testcase name="TestNested"/>
+    </testsuite>
+    <system-out>suite output</system-out>
+    <system-err>suite errors</system-err>
+  </testsuite>
+</testsuites>`
+
+func TestLoad(t *testing.T) {
+	t.Parallel()
+
+	run, err := junit.Load(strings.NewReader(testsuitesXML))
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := results.Run{
+		Name: "all",
+		Suites: []results.Suite{
+			{
+				Name:      "math",
+				Timestamp: time.Date(2024, 1, 6, 12, 0, 0, 0, time.UTC),
+				Duration:  1500 * time.Millisecond,
+				Suites: []results.Suite{
+					{
+						Name:     "math.nested",
+						Duration: 1234*time.Second + 500*time.Millisecond,
+						Tests:    []results.Test{{Name: "TestNested", Outcome: results.Passed}},
+					},
+				},
+				Tests: []results.Test{
+					{Name: "TestAdd", ClassName: "math.Calc", Outcome: results.Passed, Duration: 250 * time.Millisecond},
+					{
+						Name:      "TestDivide",
+						ClassName: "math.Calc",
+						Outcome:   results.Failed,
+						Duration:  500 * time.Millisecond,
+						Message:   "expected 2, got 3",
+						Details:   "at math_test.py:12",
+						Output:    "dividing",
+					},
+					{
+						Name:      "TestSqrt",
+						ClassName: "math.Calc",
+						Outcome:   results.Skipped,
+						Message:   "not on this platform",
+					},
+					{
+						Name:      "TestPow",
+						ClassName: "math.Calc",
+						Outcome:   results.Errored,
+						Duration:  time.Millisecond,
+						Message:   "NullPointerException",
+						Details:   "at Calc.pow(Calc.java:7)",
+					},
+				},
+				Output:      "suite output",
+				ErrorOutput: "suite errors",
+			},
+		},
+	}
+
+	if !reflect.DeepEqual(run, want) {
+		t.Errorf("Load() = %+v, want %+v", run, want)
+	}
+
+	if got, want := run.Counts(), (results.Counts{Total: 5, Passed: 2, Failed: 1, Skipped: 1, Errored: 1}); got != want {
+		t.Errorf("Counts() = %+v, want %+v", got, want)
+	}
+}
+
+func TestLoadSingleSuite(t *testing.T) {
+	t.Parallel()
+
+	run, err := junit.Load(strings.NewReader(`<testsuite name="pkg" timestamp="2024-01-06T12:00:00+02:00">
+		<testcase name="TestA" time="0.1"/>
+	</testsuite>`))
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(run.Suites) != 1 || run.Name != "pkg" || run.Suites[0].Name != "pkg" || len(run.Suites[0].Tests) != 1 {
+		t.Fatalf("Load() = %+v, want a single suite named %q with 1 test", run, "pkg")
+	}
+
+	if want := time.Date(2024, 1, 6, 10, 0, 0, 0, time.UTC); !run.Suites[0].Timestamp.Equal(want) {
+		t.Errorf("Timestamp = %v, want %v", run.Suites[0].Timestamp, want)
+	}
+}
+
+func TestLoadTime(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		time string
+		want time.Duration
+	}{
+		{time: "", want: 0},
+		{time: "0", want: 0},
+		{time: " 0.5 ", want: 500 * time.Millisecond},
+		{time: "1e3", want: 1000 * time.Second},
+		{time: "0.0000004", want: 0},
+		{time: "1,234", want: 1234 * time.Second},
+		{time: "1,234,567.25", want: 1234567250 * time.Millisecond},
+	} {
+		run, err := junit.Load(strings.NewReader(`<testsuite time="` + tc.time + `"/>`))
+
+		if err != nil || run.Suites[0].Duration != tc.want {
+			t.Errorf("Load() with time %q = %+v, %v, want a duration of %v", tc.time, run, err, tc.want)
+		}
+	}
+}
+
+func TestLoadErrorTakesPrecedenceOverFailure(t *testing.T) {
+	t.Parallel()
+
+	run, err := junit.Load(strings.NewReader(`<testsuite><testcase name="T">
+		<failure message="assertion"/><error message="crash"/>
+	</testcase></testsuite>`))
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if test := run.Suites[0].Tests[0]; test.Outcome != results.Errored || test.Message != "crash" {
+		t.Errorf("Load() test = %+v, want an errored test with message %q", test, "crash")
+	}
+}
+
+func TestLoadInvalid(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name string
+		xml  string
+		want string
+	}{
+		{name: "Empty", xml: "", want: "junit: no root element"},
+		{name: "Malformed", xml: "<testsuites>", want: "junit: XML syntax error on line 1: unexpected EOF"},
+		{
+			name: "UnknownRoot",
+			xml:  "<assemblies/>",
+			want: "junit: unexpected root element <assemblies>, want <testsuites> or <testsuite>",
+		},
+		{
+			name: "InvalidSuiteTime",
+			xml:  `<testsuites><testsuite name="s" time="soon"/></testsuites>`,
+			want: `junit: testsuite "s": invalid time "soon"`,
+		},
+		{
+			name: "NegativeTestTime",
+			xml:  `<testsuite name="s"><testcase name="t" time="-1"/></testsuite>`,
+			want: `junit: testsuite "s": testcase "t": invalid time "-1"`,
+		},
+		{
+			name: "InvalidTimestamp",
+			xml:  `<testsuite name="s" timestamp="yesterday"/>`,
+			want: `junit: testsuite "s": invalid timestamp "yesterday"`,
+		},
+		{
+			name: "NaNTime",
+			xml:  `<testsuite name="s" time="NaN"/>`,
+			want: `junit: testsuite "s": invalid time "NaN"`,
+		},
+		{
+			name: "InfiniteTime",
+			xml:  `<testsuite name="s"><testcase name="t" time="+Inf"/></testsuite>`,
+			want: `junit: testsuite "s": testcase "t": invalid time "+Inf"`,
+		},
+		{
+			name: "TooLargeTime",
+			xml:  `<testsuite name="s" time="1e300"/>`,
+			want: `junit: testsuite "s": invalid time "1e300"`,
+		},
+		{
+			name: "DecimalCommaTime",
+			xml:  `<testsuite name="s" time="0,5"/>`,
+			want: `junit: testsuite "s": invalid time "0,5"`,
+		},
+		{
+			name: "MisplacedCommaTime",
+			xml:  `<testsuite name="s" time="12,34"/>`,
+			want: `junit: testsuite "s": invalid time "12,34"`,
+		},
+		{
+			name: "InvalidNestedSuite",
+			xml:  `<testsuite name="s"><testsuite name="n" time="x"/></testsuite>`,
+			want: `junit: testsuite "n": invalid time "x"`,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			if run, err := junit.Load(strings.NewReader(tc.xml)); err == nil || err.Error() != tc.want {
+				t.Errorf("Load(%q) = %v, want %q", tc.xml, err, tc.want)
+			} else if !reflect.DeepEqual(run, results.Run{}) {
+				t.Errorf("Load(%q) = %+v, want an empty run alongside the error", tc.xml, run)
+			}
+		})
+	}
+}
+
+func TestLoadFile(t *testing.T) {
+	t.Parallel()
+
+	name := filepath.Join(t.TempDir(), "results.xml")
+
+	if err := os.WriteFile(name, []byte(testsuitesXML), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	if run, err := junit.LoadFile(name); err != nil || run.Counts().Total != 5 {
+		t.Errorf("LoadFile() = %+v, %v, want 5 tests", run, err)
+	}
+
+	if _, err := junit.LoadFile(filepath.Join(t.TempDir(), "missing.xml")); !os.IsNotExist(err) {
+		t.Errorf("LoadFile() of a missing file = %v, want a not-exist error", err)
+	}
+
+	invalid := filepath.Join(t.TempDir(), "invalid.xml")
+
+	if err := os.WriteFile(invalid, []byte("<assemblies/>"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := junit.LoadFile(invalid); err == nil || !strings.HasPrefix(err.Error(), invalid+": junit: ") {
+		t.Errorf("LoadFile() of an invalid file = %v, want an error prefixed with the file name", err)
+	}
+}
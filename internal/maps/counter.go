@@ -0,0 +1,94 @@
+// =====================================================================================================================
+// == LICENSE:       Copyright (c) 2024 Kevin De Coninck
+// ==
+// ==                Permission is hereby granted, free of charge, to any person
+// ==                obtaining a copy of this software and associated documentation
+// ==                files (the "Software"), to deal in the Software without
+// ==                restriction, including without limitation the rights to use,
+// ==                copy, modify, merge, publish, distribute, sublicense, and/or sell
+// ==                copies of the Software, and to permit persons to whom the
+// ==                Software is furnished to do so, subject to the following
+// ==                conditions:
+// ==
+// ==                The above copyright notice and this permission notice shall be
+// ==                included in all copies or substantial portions of the Software.
+// ==
+// ==                THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// ==                EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// ==                OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// ==                NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// ==                HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// ==                WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// ==                FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+// ==                OTHER DEALINGS IN THE SOFTWARE.
+// =====================================================================================================================
+
+package maps
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+// A Counter counts occurrences of keys and is safe for concurrent use by multiple goroutines.
+// The zero value is an empty counter, ready to use. A Counter must not be copied after first use.
+type Counter[K comparable] struct {
+	mu     sync.RWMutex
+	counts map[K]*atomic.Int64
+}
+
+// Inc increments the count of key k by one and returns the new count.
+func (c *Counter[K]) Inc(k K) int64 {
+	return c.Add(k, 1)
+}
+
+// Add adds n to the count of key k and returns the new count.
+func (c *Counter[K]) Add(k K, n int64) int64 {
+	c.mu.RLock()
+	cnt, ok := c.counts[k]
+	c.mu.RUnlock()
+
+	if !ok {
+		c.mu.Lock()
+
+		if cnt, ok = c.counts[k]; !ok {
+			if c.counts == nil {
+				c.counts = make(map[K]*atomic.Int64)
+			}
+
+			cnt = new(atomic.Int64)
+			c.counts[k] = cnt
+		}
+
+		c.mu.Unlock()
+	}
+
+	return cnt.Add(n)
+}
+
+// Get returns the count of key k.
+func (c *Counter[K]) Get(k K) int64 {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	if cnt, ok := c.counts[k]; ok {
+		return cnt.Load()
+	}
+
+	return 0
+}
+
+// Snapshot returns a copy of the counts of all keys.
+// Increments that happen concurrently with the snapshot may or may not be included.
+func (c *Counter[K]) Snapshot() map[K]int64 {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	snapshot := make(map[K]int64, len(c.counts))
+
+	for k, cnt := range c.counts {
+		snapshot[k] = cnt.Load()
+	}
+
+	return snapshot
+}
@@ -0,0 +1,104 @@
+// =====================================================================================================================
+// == LICENSE:       Copyright (c) 2024 Kevin De Coninck
+// ==
+// ==                Permission is hereby granted, free of charge, to any person
+// ==                obtaining a copy of this software and associated documentation
+// ==                files (the "Software"), to deal in the Software without
+// ==                restriction, including without limitation the rights to use,
+// ==                copy, modify, merge, publish, distribute, sublicense, and/or sell
+// ==                copies of the Software, and to permit persons to whom the
+// ==                Software is furnished to do so, subject to the following
+// ==                conditions:
+// ==
+// ==                The above copyright notice and this permission notice shall be
+// ==                included in all copies or substantial portions of the Software.
+// ==
+// ==                THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// ==                EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// ==                OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// ==                NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// ==                HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// ==                WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// ==                FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+// ==                OTHER DEALINGS IN THE SOFTWARE.
+// =====================================================================================================================
+
+package maps_test
+
+import (
+	"maps"
+	"sync"
+	"testing"
+
+	smaps "github.com/kdeconinck/seesharp/internal/maps"
+)
+
+func TestCounter(t *testing.T) {
+	t.Parallel()
+
+	var c smaps.Counter[string]
+
+	if got := c.Get("a"); got != 0 {
+		t.Errorf("Get on the zero value = %d, want 0", got)
+	}
+
+	if got := c.Inc("a"); got != 1 {
+		t.Errorf("Inc = %d, want 1", got)
+	}
+
+	if got := c.Add("a", 4); got != 5 {
+		t.Errorf("Add = %d, want 5", got)
+	}
+
+	if got, want := c.Snapshot(), map[string]int64{"a": 5}; !maps.Equal(got, want) {
+		t.Errorf("Snapshot() = %v, want %v", got, want)
+	}
+}
+
+func TestCounterConcurrent(t *testing.T) {
+	t.Parallel()
+
+	const (
+		goroutines = 16
+		increments = 1000
+	)
+
+	var (
+		c  smaps.Counter[int]
+		wg sync.WaitGroup
+	)
+
+	for g := range goroutines {
+		wg.Add(2)
+
+		go func() {
+			defer wg.Done()
+
+			for range increments {
+				c.Inc(g % 4)
+				c.Add(-1, 2)
+			}
+		}()
+
+		go func() {
+			defer wg.Done()
+
+			for range increments / 10 {
+				for k, v := range c.Snapshot() {
+					if v < 0 || v > goroutines*increments*2 {
+						t.Errorf("Snapshot()[%d] = %d, out of range", k, v)
+					}
+				}
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	want := map[int]int64{0: 4 * increments, 1: 4 * increments, 2: 4 * increments, 3: 4 * increments,
+		-1: 2 * goroutines * increments}
+
+	if got := c.Snapshot(); !maps.Equal(got, want) {
+		t.Errorf("Snapshot() = %v, want %v", got, want)
+	}
+}
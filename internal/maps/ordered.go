@@ -0,0 +1,96 @@
+// =====================================================================================================================
+// == LICENSE:       Copyright (c) 2024 Kevin De Coninck
+// ==
+// ==                Permission is hereby granted, free of charge, to any person
+// ==                obtaining a copy of this software and associated documentation
+// ==                files (the "Software"), to deal in the Software without
+// ==                restriction, including without limitation the rights to use,
+// ==                copy, modify, merge, publish, distribute, sublicense, and/or sell
+// ==                copies of the Software, and to permit persons to whom the
+// ==                Software is furnished to do so, subject to the following
+// ==                conditions:
+// ==
+// ==                The above copyright notice and this permission notice shall be
+// ==                included in all copies or substantial portions of the Software.
+// ==
+// ==                THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// ==                EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// ==                OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// ==                NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// ==                HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// ==                WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// ==                FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+// ==                OTHER DEALINGS IN THE SOFTWARE.
+// =====================================================================================================================
+
+// Package maps provides generic map types that are not available in the standard library.
+package maps
+
+// An Ordered is a map that remembers the order in which its keys were first inserted.
+// The zero value is an empty map, ready to use.
+type Ordered[K comparable, V any] struct {
+	keys   []K
+	values map[K]V
+}
+
+// Set sets the value of key k to v.
+// When k is already present, its value is replaced but its position is retained.
+func (m *Ordered[K, V]) Set(k K, v V) {
+	if m.values == nil {
+		m.values = make(map[K]V)
+	}
+
+	if _, ok := m.values[k]; !ok {
+		m.keys = append(m.keys, k)
+	}
+
+	m.values[k] = v
+}
+
+// Get returns the value of key k and whether k is present in m.
+func (m *Ordered[K, V]) Get(k K) (V, bool) {
+	v, ok := m.values[k]
+
+	return v, ok
+}
+
+// Delete removes key k from m.
+// Deleting a key that isn't present is a no-op.
+func (m *Ordered[K, V]) Delete(k K) {
+	if _, ok := m.values[k]; !ok {
+		return
+	}
+
+	delete(m.values, k)
+
+	for idx, key := range m.keys {
+		if key == k {
+			m.keys = append(m.keys[:idx], m.keys[idx+1:]...)
+
+			break
+		}
+	}
+}
+
+// Len returns the number of keys in m.
+func (m *Ordered[K, V]) Len() int {
+	return len(m.keys)
+}
+
+// Keys returns the keys of m in insertion order.
+func (m *Ordered[K, V]) Keys() []K {
+	keys := make([]K, len(m.keys))
+	copy(keys, m.keys)
+
+	return keys
+}
+
+// Range calls fn for each key and value in m, in insertion order.
+// When fn returns false, the iteration stops.
+func (m *Ordered[K, V]) Range(fn func(k K, v V) bool) {
+	for _, k := range m.keys {
+		if !fn(k, m.values[k]) {
+			return
+		}
+	}
+}
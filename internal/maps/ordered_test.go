@@ -0,0 +1,135 @@
+// =====================================================================================================================
+// == LICENSE:       Copyright (c) 2024 Kevin De Coninck
+// ==
+// ==                Permission is hereby granted, free of charge, to any person
+// ==                obtaining a copy of this software and associated documentation
+// ==                files (the "Software"), to deal in the Software without
+// ==                restriction, including without limitation the rights to use,
+// ==                copy, modify, merge, publish, distribute, sublicense, and/or sell
+// ==                copies of the Software, and to permit persons to whom the
+// ==                Software is furnished to do so, subject to the following
+// ==                conditions:
+// ==
+// ==                The above copyright notice and this permission notice shall be
+// ==                included in all copies or substantial portions of the Software.
+// ==
+// ==                THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// ==                EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// ==                OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// ==                NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// ==                HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// ==                WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// ==                FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+// ==                OTHER DEALINGS IN THE SOFTWARE.
+// =====================================================================================================================
+
+package maps_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/kdeconinck/seesharp/internal/maps"
+)
+
+func TestOrderedZeroValue(t *testing.T) {
+	t.Parallel()
+
+	var m maps.Ordered[string, int]
+
+	if v, ok := m.Get("a"); ok || v != 0 || m.Len() != 0 || len(m.Keys()) != 0 {
+		t.Errorf("zero value: Get = %d, %t, Len = %d, Keys = %v, want an empty map", v, ok, m.Len(), m.Keys())
+	}
+
+	m.Delete("a")
+	m.Range(func(string, int) bool {
+		t.Error("Range called fn on an empty map")
+
+		return true
+	})
+}
+
+func TestOrderedSetKeepsPosition(t *testing.T) {
+	t.Parallel()
+
+	var m maps.Ordered[string, int]
+
+	m.Set("b", 1)
+	m.Set("a", 2)
+	m.Set("c", 3)
+	m.Set("b", 4)
+
+	if got, want := m.Keys(), []string{"b", "a", "c"}; !slices.Equal(got, want) {
+		t.Errorf("Keys() = %v, want %v", got, want)
+	}
+
+	if v, ok := m.Get("b"); !ok || v != 4 {
+		t.Errorf("Get(%q) = %d, %t, want 4, true", "b", v, ok)
+	}
+}
+
+func TestOrderedDelete(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		key  string
+		want []string
+	}{
+		{key: "a", want: []string{"b", "c"}},
+		{key: "b", want: []string{"a", "c"}},
+		{key: "c", want: []string{"a", "b"}},
+		{key: "x", want: []string{"a", "b", "c"}},
+	} {
+		var m maps.Ordered[string, int]
+
+		m.Set("a", 1)
+		m.Set("b", 2)
+		m.Set("c", 3)
+		m.Delete(tc.key)
+
+		if got := m.Keys(); !slices.Equal(got, tc.want) || m.Len() != len(tc.want) {
+			t.Errorf("Delete(%q): Keys() = %v, Len() = %d, want %v", tc.key, got, m.Len(), tc.want)
+		}
+
+		if _, ok := m.Get(tc.key); ok {
+			t.Errorf("Delete(%q): key is still present", tc.key)
+		}
+	}
+}
+
+func TestOrderedKeysReturnsCopy(t *testing.T) {
+	t.Parallel()
+
+	var m maps.Ordered[string, int]
+
+	m.Set("a", 1)
+	m.Set("b", 2)
+	m.Keys()[0] = "z"
+
+	if got, want := m.Keys(), []string{"a", "b"}; !slices.Equal(got, want) {
+		t.Errorf("Keys() = %v after modifying a previous result, want %v", got, want)
+	}
+}
+
+func TestOrderedRange(t *testing.T) {
+	t.Parallel()
+
+	var (
+		m    maps.Ordered[string, int]
+		seen []string
+	)
+
+	m.Set("c", 1)
+	m.Set("a", 2)
+	m.Set("b", 3)
+
+	m.Range(func(k string, v int) bool {
+		seen = append(seen, k)
+
+		return v < 2
+	})
+
+	if want := []string{"c", "a"}; !slices.Equal(seen, want) {
+		t.Errorf("Range visited %v, want %v", seen, want)
+	}
+}
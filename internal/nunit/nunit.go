@@ -0,0 +1,263 @@
+// =====================================================================================================================
+// == LICENSE:       Copyright (c) 2024 Kevin De Coninck
+// ==
+// ==                Permission is hereby granted, free of charge, to any person
+// ==                obtaining a copy of this software and associated documentation
+// ==                files (the "Software"), to deal in the Software without
+// ==                restriction, including without limitation the rights to use,
+// ==                copy, modify, merge, publish, distribute, sublicense, and/or sell
+// ==                copies of the Software, and to permit persons to whom the
+// ==                Software is furnished to do so, subject to the following
+// ==                conditions:
+// ==
+// ==                The above copyright notice and this permission notice shall be
+// ==                included in all copies or substantial portions of the Software.
+// ==
+// ==                THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// ==                EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// ==                OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// ==                NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// ==                HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// ==                WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// ==                FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+// ==                OTHER DEALINGS IN THE SOFTWARE.
+// =====================================================================================================================
+
+// Package nunit reads test results in the NUnit 3 XML format, which is produced by the NUnit console runner and the
+// NUnit3TestAdapter.
+//
+// Every "test-suite" element, such as an assembly, namespace, fixture or parameterized method, becomes a suite, so the
+// hierarchy of the document is preserved.
+package nunit
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/kdeconinck/seesharp/internal/results"
+)
+
+// Load reads an NUnit 3 XML document from r and converts it into a results.Run.
+func Load(r io.Reader) (results.Run, error) {
+	dec := xml.NewDecoder(r)
+
+	for {
+		tok, err := dec.Token()
+
+		if err == io.EOF {
+			return results.Run{}, fmt.Errorf("nunit: no root element")
+		}
+
+		if err != nil {
+			return results.Run{}, fmt.Errorf("nunit: %w", err)
+		}
+
+		start, ok := tok.(xml.StartElement)
+
+		if !ok {
+			continue
+		}
+
+		if start.Name.Local != "test-run" {
+			return results.Run{}, fmt.Errorf("nunit: unexpected root element <%s>, want <test-run>", start.Name.Local)
+		}
+
+		var doc xmlRun
+
+		if err := dec.DecodeElement(&doc, &start); err != nil {
+			return results.Run{}, fmt.Errorf("nunit: %w", err)
+		}
+
+		suites, err := convertSuites(doc.Suites)
+
+		if err != nil {
+			return results.Run{}, err
+		}
+
+		return results.Run{Name: doc.Name, Suites: suites}, nil
+	}
+}
+
+// LoadFile reads the NUnit 3 XML document in the file name and converts it into a results.Run.
+func LoadFile(name string) (results.Run, error) {
+	f, err := os.Open(name)
+
+	if err != nil {
+		return results.Run{}, err
+	}
+
+	defer f.Close()
+
+	run, err := Load(f)
+
+	if err != nil {
+		return results.Run{}, fmt.Errorf("%s: %w", name, err)
+	}
+
+	return run, nil
+}
+
+// An xmlRun is a "test-run" element.
+type xmlRun struct {
+	Name   string     `xml:"name,attr"`
+	Suites []xmlSuite `xml:"test-suite"`
+}
+
+// An xmlSuite is a "test-suite" element.
+type xmlSuite struct {
+	Name      string     `xml:"name,attr"`
+	StartTime string     `xml:"start-time,attr"`
+	Duration  string     `xml:"duration,attr"`
+	Suites    []xmlSuite `xml:"test-suite"`
+	Cases     []xmlCase  `xml:"test-case"`
+	Output    string     `xml:"output"`
+}
+
+// An xmlCase is a "test-case" element.
+type xmlCase struct {
+	Name       string `xml:"name,attr"`
+	ClassName  string `xml:"classname,attr"`
+	Result     string `xml:"result,attr"`
+	Label      string `xml:"label,attr"`
+	Duration   string `xml:"duration,attr"`
+	Failure    string `xml:"failure>message"`
+	StackTrace string `xml:"failure>stack-trace"`
+	Reason     string `xml:"reason>message"`
+	Output     string `xml:"output"`
+}
+
+// Returns the results.Suite for each suite in suites.
+func convertSuites(suites []xmlSuite) ([]results.Suite, error) {
+	var res []results.Suite
+
+	for _, s := range suites {
+		suite, err := convertSuite(s)
+
+		if err != nil {
+			return nil, err
+		}
+
+		res = append(res, suite)
+	}
+
+	return res, nil
+}
+
+// Returns the results.Suite for s.
+func convertSuite(s xmlSuite) (results.Suite, error) {
+	d, err := parseDuration(s.Duration)
+
+	if err != nil {
+		return results.Suite{}, fmt.Errorf("nunit: test-suite %q: %w", s.Name, err)
+	}
+
+	ts, err := parseTimestamp(s.StartTime)
+
+	if err != nil {
+		return results.Suite{}, fmt.Errorf("nunit: test-suite %q: %w", s.Name, err)
+	}
+
+	nested, err := convertSuites(s.Suites)
+
+	if err != nil {
+		return results.Suite{}, err
+	}
+
+	suite := results.Suite{
+		Name:      s.Name,
+		Timestamp: ts,
+		Duration:  d,
+		Suites:    nested,
+		Output:    strings.TrimSpace(s.Output),
+	}
+
+	for _, c := range s.Cases {
+		test, err := convertCase(c)
+
+		if err != nil {
+			return results.Suite{}, fmt.Errorf("nunit: test-suite %q: %w", s.Name, err)
+		}
+
+		suite.Tests = append(suite.Tests, test)
+	}
+
+	return suite, nil
+}
+
+// Returns the results.Test for c.
+func convertCase(c xmlCase) (results.Test, error) {
+	d, err := parseDuration(c.Duration)
+
+	if err != nil {
+		return results.Test{}, fmt.Errorf("test-case %q: %w", c.Name, err)
+	}
+
+	test := results.Test{
+		Name:      c.Name,
+		ClassName: c.ClassName,
+		Duration:  d,
+		Output:    strings.TrimSpace(c.Output),
+	}
+
+	switch c.Result {
+	case "Passed", "Warning":
+		test.Outcome = results.Passed
+
+	case "Failed":
+		// A failed test with an "Error", "Cancelled" or "Invalid" label couldn't complete, rather than failing an
+		// assertion.
+		test.Outcome = results.Failed
+
+		if c.Label == "Error" || c.Label == "Cancelled" || c.Label == "Invalid" {
+			test.Outcome = results.Errored
+		}
+
+		test.Message, test.Details = strings.TrimSpace(c.Failure), strings.TrimSpace(c.StackTrace)
+
+	case "Skipped", "Inconclusive":
+		test.Outcome = results.Skipped
+		test.Message = strings.TrimSpace(c.Reason)
+
+	default:
+		return results.Test{}, fmt.Errorf("test-case %q: unknown result %q", c.Name, c.Result)
+	}
+
+	return test, nil
+}
+
+// Returns the duration described by v, a number of seconds. An empty v is a duration of 0.
+func parseDuration(v string) (time.Duration, error) {
+	if v == "" {
+		return 0, nil
+	}
+
+	secs, err := strconv.ParseFloat(v, 64)
+
+	if err != nil || math.IsNaN(secs) || secs < 0 || secs >= math.MaxInt64/float64(time.Second) {
+		return 0, fmt.Errorf("invalid duration %q", v)
+	}
+
+	return time.Duration(secs * float64(time.Second)).Round(time.Microsecond), nil
+}
+
+// Returns the time described by v, such as "2024-01-06 12:00:00Z" or "2024-01-06T12:00:00.123Z". An empty v is the
+// zero time.
+func parseTimestamp(v string) (time.Time, error) {
+	if v == "" {
+		return time.Time{}, nil
+	}
+
+	for _, layout := range []string{"2006-01-02 15:04:05.999999999Z07:00", time.RFC3339Nano} {
+		if ts, err := time.Parse(layout, v); err == nil {
+			return ts, nil
+		}
+	}
+
+	return time.Time{}, fmt.Errorf("invalid start time %q", v)
+}
@@ -0,0 +1,255 @@
+// =====================================================================================================================
+// == LICENSE:       Copyright (c) 2024 Kevin De Coninck
+// ==
+// ==                Permission is hereby granted, free of charge, to any person
+// ==                obtaining a copy of this software and associated documentation
+// ==                files (the "Software"), to deal in the Software without
+// ==                restriction, including without limitation the rights to use,
+// ==                copy, modify, merge, publish, distribute, sublicense, and/or sell
+// ==                copies of the Software, and to permit persons to whom the
+// ==                Software is furnished to do so, subject to the following
+// ==                conditions:
+// ==
+// ==                The above copyright notice and this permission notice shall be
+// ==                included in all copies or substantial portions of the Software.
+// ==
+// ==                THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// ==                EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// ==                OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// ==                NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// ==                HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// ==                WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// ==                FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+// ==                OTHER DEALINGS IN THE SOFTWARE.
+// =====================================================================================================================
+
+package nunit_test
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kdeconinck/seesharp/internal/nunit"
+	"github.com/kdeconinck/seesharp/internal/results"
+)
+
+const nunitXML = `<?xml version="1.0" encoding="utf-8" standalone="no"?>
+<test-run id="0" testcasecount="5" result="Failed" total="5" start-time="2024-01-06 12:00:00Z" duration="2.5">
+  <command-line><![CDATA[nunit3-console.exe Calc.Tests.dll]]></command-line>
+  <test-suite type="Assembly" name="Calc.Tests.dll" start-time="2024-01-06 12:00:00Z" duration="2.5">
+    <test-suite type="TestFixture" name="CalculatorTests" start-time="2024-01-06T12:00:00.25Z" duration="2">
+      <test-case name="Adds" classname="Calc.Tests.CalculatorTests" result="Passed" duration="0.25">
+        <output><![CDATA[adding]]></output>
+      </test-case>
+      <test-case name="Divides" classname="Calc.Tests.CalculatorTests" result="Failed" duration="1.5">
+        <failure>
+          <message><![CDATA[Expected: 2  But was: 3]]></message>
+          <stack-trace><![CDATA[   at Calc.Tests.CalculatorTests.Divides() in Calc.cs:line 12]]></stack-trace>
+        </failure>
+      </test-case>
+      <test-case name="Throws" classname="Calc.Tests.CalculatorTests" result="Failed" label="Error">
+        <failure><message><![CDATA[System.NullReferenceException]]></message></failure>
+      </test-case>
+      <test-case name="Ignored" classname="Calc.Tests.CalculatorTests" result="Skipped" label="Ignored">
+        <reason><message><![CDATA[Not on CI]]></message></reason>
+      </test-case>
+      <test-suite type="ParameterizedMethod" name="Multiplies" duration="0.001">
+        <test-case name="Multiplies(2,3)" classname="Calc.Tests.CalculatorTests" result="Passed" duration="0.001"/>
+      </test-suite>
+    </test-suite>
+    <output><![CDATA[assembly setup]]></output>
+  </test-suite>
+</test-run>`
+
+func TestLoad(t *testing.T) {
+	t.Parallel()
+
+	run, err := nunit.Load(strings.NewReader(nunitXML))
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := results.Run{
+		Suites: []results.Suite{
+			{
+				Name:      "Calc.Tests.dll",
+				Timestamp: time.Date(2024, 1, 6, 12, 0, 0, 0, time.UTC),
+				Duration:  2500 * time.Millisecond,
+				Output:    "assembly setup",
+				Suites: []results.Suite{
+					{
+						Name:      "CalculatorTests",
+						Timestamp: time.Date(2024, 1, 6, 12, 0, 0, 250_000_000, time.UTC),
+						Duration:  2 * time.Second,
+						Suites: []results.Suite{
+							{
+								Name:     "Multiplies",
+								Duration: time.Millisecond,
+								Tests: []results.Test{
+									{
+										Name:      "Multiplies(2,3)",
+										ClassName: "Calc.Tests.CalculatorTests",
+										Outcome:   results.Passed,
+										Duration:  time.Millisecond,
+									},
+								},
+							},
+						},
+						Tests: []results.Test{
+							{
+								Name:      "Adds",
+								ClassName: "Calc.Tests.CalculatorTests",
+								Outcome:   results.Passed,
+								Duration:  250 * time.Millisecond,
+								Output:    "adding",
+							},
+							{
+								Name:      "Divides",
+								ClassName: "Calc.Tests.CalculatorTests",
+								Outcome:   results.Failed,
+								Duration:  1500 * time.Millisecond,
+								Message:   "Expected: 2  But was: 3",
+								Details:   "at Calc.Tests.CalculatorTests.Divides() in Calc.cs:line 12",
+							},
+							{
+								Name:      "Throws",
+								ClassName: "Calc.Tests.CalculatorTests",
+								Outcome:   results.Errored,
+								Message:   "System.NullReferenceException",
+							},
+							{
+								Name:      "Ignored",
+								ClassName: "Calc.Tests.CalculatorTests",
+								Outcome:   results.Skipped,
+								Message:   "Not on CI",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	if !reflect.DeepEqual(run, want) {
+		t.Errorf("Load() = %+v, want %+v", run, want)
+	}
+
+	if got, want := run.Counts(), (results.Counts{Total: 5, Passed: 2, Failed: 1, Skipped: 1, Errored: 1}); got != want {
+		t.Errorf("Counts() = %+v, want %+v", got, want)
+	}
+}
+
+func TestLoadResults(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		attrs string
+		want  results.Outcome
+	}{
+		{attrs: `result="Passed"`, want: results.Passed},
+		{attrs: `result="Warning"`, want: results.Passed},
+		{attrs: `result="Failed"`, want: results.Failed},
+		{attrs: `result="Failed" label="Cancelled"`, want: results.Errored},
+		{attrs: `result="Failed" label="Invalid"`, want: results.Errored},
+		{attrs: `result="Skipped" label="Explicit"`, want: results.Skipped},
+		{attrs: `result="Inconclusive"`, want: results.Skipped},
+	} {
+		run, err := nunit.Load(strings.NewReader(document(tc.attrs)))
+
+		if err != nil || run.Suites[0].Tests[0].Outcome != tc.want {
+			t.Errorf("Load() with %s = %+v, %v, want %v", tc.attrs, run, err, tc.want)
+		}
+	}
+}
+
+// Returns an NUnit 3 document with a single test case that has the given attributes.
+func document(attrs string) string {
+	return `<test-run><test-suite name="s"><test-case name="t" ` + attrs + `/></test-suite></test-run>`
+}
+
+func TestLoadInvalid(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name string
+		xml  string
+		want string
+	}{
+		{name: "Empty", xml: "", want: "nunit: no root element"},
+		{name: "Malformed", xml: "<test-run>", want: "nunit: XML syntax error on line 1: unexpected EOF"},
+		{
+			name: "UnknownRoot",
+			xml:  "<testsuites/>",
+			want: "nunit: unexpected root element <testsuites>, want <test-run>",
+		},
+		{
+			name: "UnknownResult",
+			xml:  document(`result="Flaky"`),
+			want: `nunit: test-suite "s": test-case "t": unknown result "Flaky"`,
+		},
+		{
+			name: "InvalidDuration",
+			xml:  document(`result="Passed" duration="NaN"`),
+			want: `nunit: test-suite "s": test-case "t": invalid duration "NaN"`,
+		},
+		{
+			name: "TooLargeDuration",
+			xml:  `<test-run><test-suite name="s" duration="1e300"/></test-run>`,
+			want: `nunit: test-suite "s": invalid duration "1e300"`,
+		},
+		{
+			name: "InvalidStartTime",
+			xml:  `<test-run><test-suite name="s" start-time="yesterday"/></test-run>`,
+			want: `nunit: test-suite "s": invalid start time "yesterday"`,
+		},
+		{
+			name: "InvalidNestedSuite",
+			xml:  `<test-run><test-suite name="s"><test-suite name="n" duration="-1"/></test-suite></test-run>`,
+			want: `nunit: test-suite "n": invalid duration "-1"`,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			if run, err := nunit.Load(strings.NewReader(tc.xml)); err == nil || err.Error() != tc.want {
+				t.Errorf("Load(%q) = %v, want %q", tc.xml, err, tc.want)
+			} else if !reflect.DeepEqual(run, results.Run{}) {
+				t.Errorf("Load(%q) = %+v, want an empty run alongside the error", tc.xml, run)
+			}
+		})
+	}
+}
+
+func TestLoadFile(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	name := filepath.Join(dir, "TestResult.xml")
+
+	if err := os.WriteFile(name, []byte(nunitXML), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	if run, err := nunit.LoadFile(name); err != nil || run.Counts().Total != 5 {
+		t.Errorf("LoadFile() = %+v, %v, want 5 tests", run, err)
+	}
+
+	if _, err := nunit.LoadFile(filepath.Join(dir, "missing.xml")); !os.IsNotExist(err) {
+		t.Errorf("LoadFile() of a missing file = %v, want a not-exist error", err)
+	}
+
+	invalid := filepath.Join(dir, "invalid.xml")
+
+	if err := os.WriteFile(invalid, []byte("<testsuites/>"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := nunit.LoadFile(invalid); err == nil || !strings.HasPrefix(err.Error(), invalid+": nunit: ") {
+		t.Errorf("LoadFile() of an invalid file = %v, want an error prefixed with the file name", err)
+	}
+}
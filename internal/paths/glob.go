@@ -0,0 +1,239 @@
+// =====================================================================================================================
+// == LICENSE:       Copyright (c) 2024 Kevin De Coninck
+// ==
+// ==                Permission is hereby granted, free of charge, to any person
+// ==                obtaining a copy of this software and associated documentation
+// ==                files (the "Software"), to deal in the Software without
+// ==                restriction, including without limitation the rights to use,
+// ==                copy, modify, merge, publish, distribute, sublicense, and/or sell
+// ==                copies of the Software, and to permit persons to whom the
+// ==                Software is furnished to do so, subject to the following
+// ==                conditions:
+// ==
+// ==                The above copyright notice and this permission notice shall be
+// ==                included in all copies or substantial portions of the Software.
+// ==
+// ==                THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// ==                EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// ==                OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// ==                NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// ==                HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// ==                WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// ==                FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+// ==                OTHER DEALINGS IN THE SOFTWARE.
+// =====================================================================================================================
+
+// Package paths provides functions for working with file system paths that complement "path/filepath".
+package paths
+
+import (
+	"io/fs"
+	"path/filepath"
+	"slices"
+	"strings"
+)
+
+// Glob returns the names of all files matching pattern, or nil if there is no matching file.
+//
+// On top of the syntax supported by filepath.Match, pattern may contain:
+//   - A "**" path segment, which matches zero or more directories.
+//   - Brace expansions such as "{unit,integration}", which match any of the comma-separated alternatives. Braces may
+//     be nested.
+//
+// Pattern segments are separated by "/" on all operating systems, and by "\" as well on Windows. The returned names
+// use the separator of the operating system and are sorted. As with filepath.Glob, I/O errors are ignored and the
+// only possible returned error is filepath.ErrBadPattern.
+func Glob(pattern string) ([]string, error) {
+	patterns, err := expandBraces(filepath.ToSlash(pattern))
+
+	if err != nil {
+		return nil, err
+	}
+
+	var matches []string
+
+	for _, p := range patterns {
+		m, err := glob(p)
+
+		if err != nil {
+			return nil, err
+		}
+
+		matches = append(matches, m...)
+	}
+
+	if len(matches) == 0 {
+		return nil, nil
+	}
+
+	slices.Sort(matches)
+
+	return slices.Compact(matches), nil
+}
+
+// Returns the names of all files matching pattern, which doesn't contain brace expansions.
+func glob(pattern string) ([]string, error) {
+	segments := strings.Split(pattern, "/")
+
+	if !slices.Contains(segments, "**") {
+		return filepath.Glob(filepath.FromSlash(pattern))
+	}
+
+	for _, segment := range segments {
+		if _, err := filepath.Match(segment, ""); err != nil {
+			return nil, err
+		}
+	}
+
+	root, segments := splitRoot(pattern)
+
+	var matches []string
+
+	_ = filepath.WalkDir(root, func(name string, _ fs.DirEntry, err error) error {
+		if err != nil {
+			return nil
+		}
+
+		rel, err := filepath.Rel(root, name)
+
+		if err != nil || rel == "." {
+			return nil
+		}
+
+		if matchSegments(segments, strings.Split(filepath.ToSlash(rel), "/")) {
+			if root == "." {
+				matches = append(matches, rel)
+			} else {
+				matches = append(matches, name)
+			}
+		}
+
+		return nil
+	})
+
+	return matches, nil
+}
+
+// Splits pattern, which uses "/" as separator, into the directory to walk and the pattern segments that are matched
+// against the paths below it. The directory is the longest leading part of pattern that doesn't contain any meta
+// characters, including the volume name of pattern, if any.
+func splitRoot(pattern string) (string, []string) {
+	volume := filepath.VolumeName(pattern)
+	segments := strings.Split(pattern[len(volume):], "/")
+	idx := 0
+
+	for idx < len(segments) && !hasMeta(segments[idx]) {
+		idx++
+	}
+
+	root := strings.Join(segments[:idx], "/")
+
+	switch {
+	case idx == 1 && root == "":
+		// The pattern is rooted, such as "/**" or "C:/**".
+		root = "/"
+	case idx == 0 && volume == "":
+		root = "."
+	}
+
+	return filepath.FromSlash(volume + root), segments[idx:]
+}
+
+// Reports whether the path segments in name match the pattern segments in pattern.
+// A "**" pattern segment matches zero or more path segments.
+func matchSegments(pattern, name []string) bool {
+	if len(pattern) == 0 {
+		return len(name) == 0
+	}
+
+	if pattern[0] == "**" {
+		return matchSegments(pattern[1:], name) || (len(name) > 0 && matchSegments(pattern, name[1:]))
+	}
+
+	if len(name) == 0 {
+		return false
+	}
+
+	ok, _ := filepath.Match(pattern[0], name[0])
+
+	return ok && matchSegments(pattern[1:], name[1:])
+}
+
+// Reports whether segment contains any of the meta characters recognized by filepath.Match.
+func hasMeta(segment string) bool {
+	return strings.ContainsAny(segment, `*?[\`)
+}
+
+// Returns all the patterns described by the brace expansions in pattern.
+// A pattern without braces expands to itself.
+func expandBraces(pattern string) ([]string, error) {
+	start, end, alternatives, err := findBraces(pattern)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if start < 0 {
+		return []string{pattern}, nil
+	}
+
+	var patterns []string
+
+	for _, alt := range alternatives {
+		expanded, err := expandBraces(pattern[:start] + alt + pattern[end+1:])
+
+		if err != nil {
+			return nil, err
+		}
+
+		patterns = append(patterns, expanded...)
+	}
+
+	return patterns, nil
+}
+
+// Returns the position of the first top-level pair of braces in pattern together with the comma-separated
+// alternatives between them. When pattern doesn't contain braces, start is -1.
+func findBraces(pattern string) (start, end int, alternatives []string, err error) {
+	var (
+		depth int
+		from  int
+	)
+
+	start = -1
+
+	for idx := 0; idx < len(pattern); idx++ {
+		switch pattern[idx] {
+		case '\\':
+			idx++
+
+		case '{':
+			if depth == 0 {
+				start, from = idx, idx+1
+			}
+
+			depth++
+
+		case ',':
+			if depth == 1 {
+				alternatives = append(alternatives, pattern[from:idx])
+				from = idx + 1
+			}
+
+		case '}':
+			if depth == 0 {
+				return 0, 0, nil, filepath.ErrBadPattern
+			}
+
+			if depth--; depth == 0 {
+				return start, idx, append(alternatives, pattern[from:idx]), nil
+			}
+		}
+	}
+
+	if depth != 0 {
+		return 0, 0, nil, filepath.ErrBadPattern
+	}
+
+	return -1, -1, nil, nil
+}
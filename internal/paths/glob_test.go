@@ -0,0 +1,176 @@
+// =====================================================================================================================
+// == LICENSE:       Copyright (c) 2024 Kevin De Coninck
+// ==
+// ==                Permission is hereby granted, free of charge, to any person
+// ==                obtaining a copy of this software and associated documentation
+// ==                files (the "Software"), to deal in the Software without
+// ==                restriction, including without limitation the rights to use,
+// ==                copy, modify, merge, publish, distribute, sublicense, and/or sell
+// ==                copies of the Software, and to permit persons to whom the
+// ==                Software is furnished to do so, subject to the following
+// ==                conditions:
+// ==
+// ==                The above copyright notice and this permission notice shall be
+// ==                included in all copies or substantial portions of the Software.
+// ==
+// ==                THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// ==                EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// ==                OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// ==                NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// ==                HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// ==                WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// ==                FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+// ==                OTHER DEALINGS IN THE SOFTWARE.
+// =====================================================================================================================
+
+package paths_test
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"runtime"
+	"slices"
+	"testing"
+
+	"github.com/kdeconinck/seesharp/internal/paths"
+)
+
+// Creates the files in names, which use "/" as separator, below a new temporary directory and returns that directory.
+func createTree(t *testing.T, names ...string) string {
+	t.Helper()
+
+	root := t.TempDir()
+
+	for _, name := range names {
+		name = filepath.Join(root, filepath.FromSlash(name))
+
+		if err := os.MkdirAll(filepath.Dir(name), 0o755); err != nil {
+			t.Fatal(err)
+		}
+
+		if err := os.WriteFile(name, nil, 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	return root
+}
+
+// Returns names, which use "/" as separator, joined to root.
+func joinAll(root string, names ...string) []string {
+	res := make([]string, len(names))
+
+	for idx, name := range names {
+		res[idx] = filepath.Join(root, filepath.FromSlash(name))
+	}
+
+	return res
+}
+
+func TestGlob(t *testing.T) {
+	t.Parallel()
+
+	root := createTree(t,
+		"top.xml",
+		"unit/TestResults/a.xml",
+		"unit/nested/TestResults/b.xml",
+		"integration/TestResults/c.trx",
+		"integration/TestResults/d.txt",
+		"e2e/TestResults/e.xml",
+	)
+	base := filepath.ToSlash(root)
+
+	for _, tc := range []struct {
+		pattern string
+		want    []string
+	}{
+		{
+			pattern: base + "/**/TestResults/*.xml",
+			want:    []string{"e2e/TestResults/e.xml", "unit/TestResults/a.xml", "unit/nested/TestResults/b.xml"},
+		},
+		{
+			pattern: base + "/**/*.xml",
+			want: []string{
+				"e2e/TestResults/e.xml", "top.xml", "unit/TestResults/a.xml", "unit/nested/TestResults/b.xml",
+			},
+		},
+		{pattern: base + "/unit/**/TestResults/a.xml", want: []string{"unit/TestResults/a.xml"}},
+		{pattern: base + "/unit/**", want: []string{
+			"unit/TestResults", "unit/TestResults/a.xml", "unit/nested", "unit/nested/TestResults",
+			"unit/nested/TestResults/b.xml",
+		}},
+		{
+			pattern: base + "/{unit,integration}/TestResults/*.{xml,trx}",
+			want:    []string{"integration/TestResults/c.trx", "unit/TestResults/a.xml"},
+		},
+		{
+			pattern: base + "/{unit/{nested/,},e2e/}TestResults/*",
+			want:    []string{"e2e/TestResults/e.xml", "unit/TestResults/a.xml", "unit/nested/TestResults/b.xml"},
+		},
+		{pattern: base + "/{unit,unit}/TestResults/a.xml", want: []string{"unit/TestResults/a.xml"}},
+		{pattern: base + "/**/missing.xml", want: nil},
+		{pattern: base + "/missing/**/*.xml", want: nil},
+	} {
+		got, err := paths.Glob(tc.pattern)
+
+		if want := joinAll(root, tc.want...); err != nil || !slices.Equal(got, want) {
+			t.Errorf("Glob(%q) = %q, %v, want %q, <nil>", tc.pattern, got, err, want)
+		}
+	}
+}
+
+func TestGlobEscapes(t *testing.T) {
+	t.Parallel()
+
+	if runtime.GOOS == "windows" {
+		t.Skip("\\ is a path separator on Windows")
+	}
+
+	root := createTree(t, "a/*.xml", "a/b.xml", "a/{c}.xml")
+
+	for _, tc := range []struct {
+		pattern string
+		want    []string
+	}{
+		{pattern: root + `/**/\*.xml`, want: []string{"a/*.xml"}},
+		{pattern: root + `/**/\{c\}.xml`, want: []string{"a/{c}.xml"}},
+	} {
+		got, err := paths.Glob(tc.pattern)
+
+		if want := joinAll(root, tc.want...); err != nil || !slices.Equal(got, want) {
+			t.Errorf("Glob(%q) = %q, %v, want %q, <nil>", tc.pattern, got, err, want)
+		}
+	}
+}
+
+func TestGlobRelative(t *testing.T) {
+	root := createTree(t, "a/TestResults/x.xml", "b/y.xml")
+	wd, err := os.Getwd()
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := os.Chdir(root); err != nil {
+		t.Fatal(err)
+	}
+
+	t.Cleanup(func() { _ = os.Chdir(wd) })
+
+	got, err := paths.Glob("**/*.xml")
+
+	if want := joinAll("", "a/TestResults/x.xml", "b/y.xml"); err != nil || !slices.Equal(got, want) {
+		t.Errorf("Glob(%q) = %q, %v, want %q, <nil>", "**/*.xml", got, err, want)
+	}
+}
+
+func TestGlobBadPattern(t *testing.T) {
+	t.Parallel()
+
+	for _, pattern := range []string{"a/{b", "a/b}", "{a,{b}", "**/[a", "[a", "a/**/x["} {
+		if got, err := paths.Glob(pattern); !errors.Is(err, filepath.ErrBadPattern) {
+			t.Errorf("Glob(%q) = %q, %v, want %v", pattern, got, err, filepath.ErrBadPattern)
+		}
+	}
+}
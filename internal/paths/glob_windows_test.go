@@ -0,0 +1,56 @@
+// =====================================================================================================================
+// == LICENSE:       Copyright (c) 2024 Kevin De Coninck
+// ==
+// ==                Permission is hereby granted, free of charge, to any person
+// ==                obtaining a copy of this software and associated documentation
+// ==                files (the "Software"), to deal in the Software without
+// ==                restriction, including without limitation the rights to use,
+// ==                copy, modify, merge, publish, distribute, sublicense, and/or sell
+// ==                copies of the Software, and to permit persons to whom the
+// ==                Software is furnished to do so, subject to the following
+// ==                conditions:
+// ==
+// ==                The above copyright notice and this permission notice shall be
+// ==                included in all copies or substantial portions of the Software.
+// ==
+// ==                THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// ==                EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// ==                OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// ==                NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// ==                HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// ==                WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// ==                FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+// ==                OTHER DEALINGS IN THE SOFTWARE.
+// =====================================================================================================================
+
+//go:build windows
+
+package paths
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestSplitRoot(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		pattern  string
+		root     string
+		segments []string
+	}{
+		{pattern: "**/*.xml", root: ".", segments: []string{"**", "*.xml"}},
+		{pattern: "C:/**", root: `C:\`, segments: []string{"**"}},
+		{pattern: "C:/x/**/*.xml", root: `C:\x`, segments: []string{"**", "*.xml"}},
+		{pattern: "C:**", root: "C:", segments: []string{"**"}},
+		{pattern: "C:x/**", root: `C:x`, segments: []string{"**"}},
+		{pattern: "//server/share/**", root: `\\server\share\`, segments: []string{"**"}},
+		{pattern: "//server/share/out/**", root: `\\server\share\out`, segments: []string{"**"}},
+		{pattern: "/**", root: `\`, segments: []string{"**"}},
+	} {
+		if root, segments := splitRoot(tc.pattern); root != tc.root || !slices.Equal(segments, tc.segments) {
+			t.Errorf("splitRoot(%q) = %q, %q, want %q, %q", tc.pattern, root, segments, tc.root, tc.segments)
+		}
+	}
+}
@@ -0,0 +1,68 @@
+// =====================================================================================================================
+// == LICENSE:       Copyright (c) 2024 Kevin De Coninck
+// ==
+// ==                Permission is hereby granted, free of charge, to any person
+// ==                obtaining a copy of this software and associated documentation
+// ==                files (the "Software"), to deal in the Software without
+// ==                restriction, including without limitation the rights to use,
+// ==                copy, modify, merge, publish, distribute, sublicense, and/or sell
+// ==                copies of the Software, and to permit persons to whom the
+// ==                Software is furnished to do so, subject to the following
+// ==                conditions:
+// ==
+// ==                The above copyright notice and this permission notice shall be
+// ==                included in all copies or substantial portions of the Software.
+// ==
+// ==                THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// ==                EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// ==                OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// ==                NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// ==                HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// ==                WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// ==                FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+// ==                OTHER DEALINGS IN THE SOFTWARE.
+// =====================================================================================================================
+
+package paths_test
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+
+	"github.com/kdeconinck/seesharp/internal/paths"
+)
+
+func TestSecureJoin(t *testing.T) {
+	t.Parallel()
+
+	root := filepath.FromSlash("/srv/uploads")
+
+	for _, tc := range []struct {
+		rel  string
+		want string
+	}{
+		{rel: "results.xml", want: "/srv/uploads/results.xml"},
+		{rel: "a/b/results.xml", want: "/srv/uploads/a/b/results.xml"},
+		{rel: "a/../b.xml", want: "/srv/uploads/b.xml"},
+		{rel: "./a//b.xml", want: "/srv/uploads/a/b.xml"},
+		{rel: ".", want: "/srv/uploads"},
+		{rel: "..results.xml", want: "/srv/uploads/..results.xml"},
+	} {
+		got, err := paths.SecureJoin(root, filepath.FromSlash(tc.rel))
+
+		if want := filepath.FromSlash(tc.want); err != nil || got != want {
+			t.Errorf("SecureJoin(%q, %q) = %q, %v, want %q, <nil>", root, tc.rel, got, err, want)
+		}
+	}
+}
+
+func TestSecureJoinUnsafe(t *testing.T) {
+	t.Parallel()
+
+	for _, rel := range []string{"", "..", "../x", "a/../../x", "a/../..", "/etc/passwd", "/"} {
+		if got, err := paths.SecureJoin("/srv/uploads", filepath.FromSlash(rel)); !errors.Is(err, paths.ErrUnsafePath) {
+			t.Errorf("SecureJoin(%q) = %q, %v, want %v", rel, got, err, paths.ErrUnsafePath)
+		}
+	}
+}
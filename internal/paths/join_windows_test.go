@@ -0,0 +1,47 @@
+// =====================================================================================================================
+// == LICENSE:       Copyright (c) 2024 Kevin De Coninck
+// ==
+// ==                Permission is hereby granted, free of charge, to any person
+// ==                obtaining a copy of this software and associated documentation
+// ==                files (the "Software"), to deal in the Software without
+// ==                restriction, including without limitation the rights to use,
+// ==                copy, modify, merge, publish, distribute, sublicense, and/or sell
+// ==                copies of the Software, and to permit persons to whom the
+// ==                Software is furnished to do so, subject to the following
+// ==                conditions:
+// ==
+// ==                The above copyright notice and this permission notice shall be
+// ==                included in all copies or substantial portions of the Software.
+// ==
+// ==                THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// ==                EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// ==                OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// ==                NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// ==                HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// ==                WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// ==                FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+// ==                OTHER DEALINGS IN THE SOFTWARE.
+// =====================================================================================================================
+
+//go:build windows
+
+package paths_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/kdeconinck/seesharp/internal/paths"
+)
+
+func TestSecureJoinUnsafeWindows(t *testing.T) {
+	t.Parallel()
+
+	for _, rel := range []string{
+		`C:\Windows`, `C:Windows`, `C:`, `\Windows`, `\\server\share\x`, `\\?\C:\x`, `a\..\..\x`, `NUL`, `COM1`, `a\aux`,
+	} {
+		if got, err := paths.SecureJoin(`C:\uploads`, rel); !errors.Is(err, paths.ErrUnsafePath) {
+			t.Errorf("SecureJoin(%q) = %q, %v, want %v", rel, got, err, paths.ErrUnsafePath)
+		}
+	}
+}
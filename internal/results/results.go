@@ -0,0 +1,152 @@
+// =====================================================================================================================
+// == LICENSE:       Copyright (c) 2024 Kevin De Coninck
+// ==
+// ==                Permission is hereby granted, free of charge, to any person
+// ==                obtaining a copy of this software and associated documentation
+// ==                files (the "Software"), to deal in the Software without
+// ==                restriction, including without limitation the rights to use,
+// ==                copy, modify, merge, publish, distribute, sublicense, and/or sell
+// ==                copies of the Software, and to permit persons to whom the
+// ==                Software is furnished to do so, subject to the following
+// ==                conditions:
+// ==
+// ==                The above copyright notice and this permission notice shall be
+// ==                included in all copies or substantial portions of the Software.
+// ==
+// ==                THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// ==                EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// ==                OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// ==                NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// ==                HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// ==                WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// ==                FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+// ==                OTHER DEALINGS IN THE SOFTWARE.
+// =====================================================================================================================
+
+// Package results defines the format-agnostic model of a test run that the readers of the supported test result
+// formats produce, and that the commands which report on test results consume.
+package results
+
+import "time"
+
+// An Outcome is the result of a single test.
+type Outcome int
+
+// The possible outcomes of a test.
+const (
+	Passed  Outcome = iota // The test ran and all its assertions held.
+	Failed                 // The test ran and an assertion failed.
+	Skipped                // The test didn't run.
+	Errored                // The test couldn't complete because of an unexpected error, such as an exception.
+)
+
+// String returns the lowercase name of o, such as "passed".
+func (o Outcome) String() string {
+	switch o {
+	case Passed:
+		return "passed"
+	case Failed:
+		return "failed"
+	case Skipped:
+		return "skipped"
+	case Errored:
+		return "errored"
+	default:
+		return "unknown"
+	}
+}
+
+// A Run is the result of a single invocation of a test runner.
+type Run struct {
+	Name   string
+	Suites []Suite
+}
+
+// A Suite is a group of tests, such as a test assembly, class or package. Suites may be nested.
+type Suite struct {
+	Name        string
+	Timestamp   time.Time // The time at which the suite started, or the zero time if unknown.
+	Duration    time.Duration
+	Suites      []Suite
+	Tests       []Test
+	Output      string // The standard output captured while running the suite.
+	ErrorOutput string // The standard error captured while running the suite.
+}
+
+// A Test is the result of a single test.
+type Test struct {
+	Name        string
+	ClassName   string // The name of the class or package that declares the test, if known.
+	Outcome     Outcome
+	Duration    time.Duration
+	Message     string // Why the test didn't pass, such as the assertion message or the reason for skipping it.
+	Details     string // Additional information on why the test didn't pass, such as a stack trace.
+	Output      string // The standard output captured while running the test.
+	ErrorOutput string // The standard error captured while running the test.
+}
+
+// Counts holds the number of tests per outcome.
+type Counts struct {
+	Total   int
+	Passed  int
+	Failed  int
+	Skipped int
+	Errored int
+}
+
+// Counts returns the number of tests per outcome in all the suites of r.
+func (r Run) Counts() Counts {
+	var c Counts
+
+	for _, s := range r.Suites {
+		c.add(s.Counts())
+	}
+
+	return c
+}
+
+// Duration returns the sum of the durations of the top-level suites of r.
+func (r Run) Duration() time.Duration {
+	var d time.Duration
+
+	for _, s := range r.Suites {
+		d += s.Duration
+	}
+
+	return d
+}
+
+// Counts returns the number of tests per outcome in s, including the tests in its nested suites.
+func (s Suite) Counts() Counts {
+	var c Counts
+
+	for _, t := range s.Tests {
+		c.Total++
+
+		switch t.Outcome {
+		case Passed:
+			c.Passed++
+		case Failed:
+			c.Failed++
+		case Skipped:
+			c.Skipped++
+		case Errored:
+			c.Errored++
+		}
+	}
+
+	for _, nested := range s.Suites {
+		c.add(nested.Counts())
+	}
+
+	return c
+}
+
+// Adds the counts in other to c.
+func (c *Counts) add(other Counts) {
+	c.Total += other.Total
+	c.Passed += other.Passed
+	c.Failed += other.Failed
+	c.Skipped += other.Skipped
+	c.Errored += other.Errored
+}
@@ -0,0 +1,86 @@
+// =====================================================================================================================
+// == LICENSE:       Copyright (c) 2024 Kevin De Coninck
+// ==
+// ==                Permission is hereby granted, free of charge, to any person
+// ==                obtaining a copy of this software and associated documentation
+// ==                files (the "Software"), to deal in the Software without
+// ==                restriction, including without limitation the rights to use,
+// ==                copy, modify, merge, publish, distribute, sublicense, and/or sell
+// ==                copies of the Software, and to permit persons to whom the
+// ==                Software is furnished to do so, subject to the following
+// ==                conditions:
+// ==
+// ==                The above copyright notice and this permission notice shall be
+// ==                included in all copies or substantial portions of the Software.
+// ==
+// ==                THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// ==                EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// ==                OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// ==                NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// ==                HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// ==                WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// ==                FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+// ==                OTHER DEALINGS IN THE SOFTWARE.
+// =====================================================================================================================
+
+package results_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/kdeconinck/seesharp/internal/results"
+)
+
+func TestOutcomeString(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		outcome results.Outcome
+		want    string
+	}{
+		{outcome: results.Passed, want: "passed"},
+		{outcome: results.Failed, want: "failed"},
+		{outcome: results.Skipped, want: "skipped"},
+		{outcome: results.Errored, want: "errored"},
+		{outcome: results.Outcome(42), want: "unknown"},
+	} {
+		if got := tc.outcome.String(); got != tc.want {
+			t.Errorf("Outcome(%d).String() = %q, want %q", tc.outcome, got, tc.want)
+		}
+	}
+}
+
+func TestRunCounts(t *testing.T) {
+	t.Parallel()
+
+	run := results.Run{
+		Suites: []results.Suite{
+			{
+				Duration: time.Second,
+				Tests:    []results.Test{{Outcome: results.Passed}, {Outcome: results.Failed}},
+				Suites: []results.Suite{
+					{Tests: []results.Test{{Outcome: results.Skipped}, {Outcome: results.Passed}}},
+				},
+			},
+			{
+				Duration: 2 * time.Second,
+				Tests:    []results.Test{{Outcome: results.Errored}},
+			},
+		},
+	}
+
+	want := results.Counts{Total: 5, Passed: 2, Failed: 1, Skipped: 1, Errored: 1}
+
+	if got := run.Counts(); got != want {
+		t.Errorf("Counts() = %+v, want %+v", got, want)
+	}
+
+	if got := run.Duration(); got != 3*time.Second {
+		t.Errorf("Duration() = %v, want %v", got, 3*time.Second)
+	}
+
+	if got := (results.Run{}).Counts(); got != (results.Counts{}) {
+		t.Errorf("Counts() of an empty run = %+v, want zero counts", got)
+	}
+}
@@ -0,0 +1,106 @@
+// =====================================================================================================================
+// == LICENSE:       Copyright (c) 2024 Kevin De Coninck
+// ==
+// ==                Permission is hereby granted, free of charge, to any person
+// ==                obtaining a copy of this software and associated documentation
+// ==                files (the "Software"), to deal in the Software without
+// ==                restriction, including without limitation the rights to use,
+// ==                copy, modify, merge, publish, distribute, sublicense, and/or sell
+// ==                copies of the Software, and to permit persons to whom the
+// ==                Software is furnished to do so, subject to the following
+// ==                conditions:
+// ==
+// ==                The above copyright notice and this permission notice shall be
+// ==                included in all copies or substantial portions of the Software.
+// ==
+// ==                THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// ==                EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// ==                OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// ==                NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// ==                HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// ==                WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// ==                FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+// ==                OTHER DEALINGS IN THE SOFTWARE.
+// =====================================================================================================================
+
+package secrets
+
+import (
+	"io"
+	"slices"
+	"strings"
+	"sync"
+)
+
+// The text that replaces a redacted secret.
+const redacted = "[REDACTED]"
+
+// A Redactor replaces secrets in text. It's safe for concurrent use.
+// The zero value is a Redactor without any secrets, which returns text unchanged.
+type Redactor struct {
+	mu       sync.RWMutex
+	secrets  []string // Sorted by descending length, so a secret that contains another one is replaced as a whole.
+	replacer *strings.Replacer
+}
+
+// Add registers secret, so that it's replaced in the text redacted from now on. Empty secrets are ignored.
+func (r *Redactor) Add(secret string) {
+	if secret == "" {
+		return
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if slices.Contains(r.secrets, secret) {
+		return
+	}
+
+	r.secrets = append(r.secrets, secret)
+
+	slices.SortStableFunc(r.secrets, func(a, b string) int {
+		return len(b) - len(a)
+	})
+
+	pairs := make([]string, 0, 2*len(r.secrets))
+
+	for _, s := range r.secrets {
+		pairs = append(pairs, s, redacted)
+	}
+
+	r.replacer = strings.NewReplacer(pairs...)
+}
+
+// Redact returns s with every registered secret replaced by "[REDACTED]".
+func (r *Redactor) Redact(s string) string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	if r.replacer == nil {
+		return s
+	}
+
+	return r.replacer.Replace(s)
+}
+
+// Writer returns a writer that redacts the data written to it before passing it on to w.
+// Secrets are only replaced when they're contained in a single call to Write, which is the case for the lines written
+// by a log.Logger or a slog.Handler.
+func (r *Redactor) Writer(w io.Writer) io.Writer {
+	return &redactingWriter{r: r, w: w}
+}
+
+// A redactingWriter is the writer returned by Redactor.Writer.
+type redactingWriter struct {
+	r *Redactor
+	w io.Writer
+}
+
+// Write writes p to the underlying writer with every registered secret replaced.
+func (rw *redactingWriter) Write(p []byte) (int, error) {
+	if _, err := io.WriteString(rw.w, rw.r.Redact(string(p))); err != nil {
+		return 0, err
+	}
+
+	return len(p), nil
+}
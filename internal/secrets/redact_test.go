@@ -0,0 +1,133 @@
+// =====================================================================================================================
+// == LICENSE:       Copyright (c) 2024 Kevin De Coninck
+// ==
+// ==                Permission is hereby granted, free of charge, to any person
+// ==                obtaining a copy of this software and associated documentation
+// ==                files (the "Software"), to deal in the Software without
+// ==                restriction, including without limitation the rights to use,
+// ==                copy, modify, merge, publish, distribute, sublicense, and/or sell
+// ==                copies of the Software, and to permit persons to whom the
+// ==                Software is furnished to do so, subject to the following
+// ==                conditions:
+// ==
+// ==                The above copyright notice and this permission notice shall be
+// ==                included in all copies or substantial portions of the Software.
+// ==
+// ==                THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// ==                EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// ==                OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// ==                NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// ==                HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// ==                WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// ==                FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+// ==                OTHER DEALINGS IN THE SOFTWARE.
+// =====================================================================================================================
+
+package secrets_test
+
+import (
+	"bytes"
+	"errors"
+	"log"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/kdeconinck/seesharp/internal/secrets"
+)
+
+func TestRedact(t *testing.T) {
+	t.Parallel()
+
+	var r secrets.Redactor
+
+	if got := r.Redact("token=abc"); got != "token=abc" {
+		t.Errorf("Redact() without secrets = %q, want %q", got, "token=abc")
+	}
+
+	r.Add("abc")
+	r.Add("abcdef")
+	r.Add("abc")
+	r.Add("")
+
+	for _, tc := range []struct {
+		s    string
+		want string
+	}{
+		{s: "token=abc", want: "token=[REDACTED]"},
+		{s: "token=abcdef", want: "token=[REDACTED]"},
+		{s: "abc abc", want: "[REDACTED] [REDACTED]"},
+		{s: "ab", want: "ab"},
+		{s: "", want: ""},
+	} {
+		if got := r.Redact(tc.s); got != tc.want {
+			t.Errorf("Redact(%q) = %q, want %q", tc.s, got, tc.want)
+		}
+	}
+}
+
+func TestRedactorWriter(t *testing.T) {
+	t.Parallel()
+
+	var (
+		r   secrets.Redactor
+		buf bytes.Buffer
+	)
+
+	logger := log.New(r.Writer(&buf), "", 0)
+	r.Add("xoxb-123")
+	logger.Printf("POST https://slack.com/api/chat.postMessage (Authorization: Bearer %s)", "xoxb-123")
+
+	if want := "POST https://slack.com/api/chat.postMessage (Authorization: Bearer [REDACTED])\n"; buf.String() != want {
+		t.Errorf("logged %q, want %q", buf.String(), want)
+	}
+}
+
+// A failingWriter is an io.Writer that always fails.
+type failingWriter struct{}
+
+// Write returns an error.
+func (failingWriter) Write([]byte) (int, error) {
+	return 0, errors.New("disk full")
+}
+
+func TestRedactorWriterError(t *testing.T) {
+	t.Parallel()
+
+	var r secrets.Redactor
+
+	if n, err := r.Writer(failingWriter{}).Write([]byte("data")); n != 0 || err == nil {
+		t.Errorf("Write() = %d, %v, want 0 and an error", n, err)
+	}
+}
+
+func TestRedactorConcurrentUse(t *testing.T) {
+	t.Parallel()
+
+	var (
+		r  secrets.Redactor
+		wg sync.WaitGroup
+	)
+
+	for idx := range 8 {
+		wg.Add(2)
+
+		go func() {
+			defer wg.Done()
+
+			r.Add(string(rune('a' + idx)))
+		}()
+
+		go func() {
+			defer wg.Done()
+
+			_ = r.Redact("abcdefgh")
+		}()
+	}
+
+	wg.Wait()
+
+	if got, want := r.Redact("abcdefgh"), strings.Repeat("[REDACTED]", 8); got != want {
+		t.Errorf("Redact() = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,168 @@
+// =====================================================================================================================
+// == LICENSE:       Copyright (c) 2024 Kevin De Coninck
+// ==
+// ==                Permission is hereby granted, free of charge, to any person
+// ==                obtaining a copy of this software and associated documentation
+// ==                files (the "Software"), to deal in the Software without
+// ==                restriction, including without limitation the rights to use,
+// ==                copy, modify, merge, publish, distribute, sublicense, and/or sell
+// ==                copies of the Software, and to permit persons to whom the
+// ==                Software is furnished to do so, subject to the following
+// ==                conditions:
+// ==
+// ==                The above copyright notice and this permission notice shall be
+// ==                included in all copies or substantial portions of the Software.
+// ==
+// ==                THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// ==                EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// ==                OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// ==                NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// ==                HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// ==                WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// ==                FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+// ==                OTHER DEALINGS IN THE SOFTWARE.
+// =====================================================================================================================
+
+// Package secrets resolves the credentials used by publishers and notifiers, such as API tokens and webhook URLs, and
+// keeps them out of debug logs.
+//
+// A credential is configured as a reference:
+//   - "env:NAME" reads the environment variable NAME.
+//   - "keychain:SERVICE/ACCOUNT" reads the password stored for ACCOUNT under SERVICE in the keychain of the operating
+//     system.
+//   - Any other value is used literally.
+//
+// Every credential that is resolved is registered with the Resolver's Redactor, which replaces it in the text written
+// to the logs.
+package secrets
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// The service under which Lookup searches the keychain.
+const keychainService = "seesharp"
+
+// ErrNotFound is returned when a credential isn't set in the environment variable or the keychain entry it refers to.
+var ErrNotFound = errors.New("secrets: credential not found")
+
+// A Keychain reads passwords from a credential store.
+type Keychain interface {
+	// Get returns the password stored for account under service, or an error wrapping ErrNotFound if there is none.
+	Get(service, account string) (string, error)
+}
+
+// A Resolver resolves credential references.
+type Resolver struct {
+	// Keychain is the store used for "keychain:" references and by Lookup. A nil Keychain disables keychain support.
+	Keychain Keychain
+
+	// Redactor, if not nil, registers every resolved credential.
+	Redactor *Redactor
+
+	// LookupEnv reads an environment variable. A nil LookupEnv uses os.LookupEnv.
+	LookupEnv func(key string) (string, bool)
+}
+
+// NewResolver returns a Resolver that uses the environment and the keychain of the operating system, and registers
+// every resolved credential with redactor.
+func NewResolver(redactor *Redactor) *Resolver {
+	return &Resolver{Keychain: OSKeychain(), Redactor: redactor}
+}
+
+// Resolve returns the credential that ref refers to.
+// An empty environment variable is treated as unset, so a credential is never resolved to the empty string unless ref
+// itself is empty.
+func (r *Resolver) Resolve(ref string) (string, error) {
+	var (
+		v   string
+		err error
+	)
+
+	switch {
+	case strings.HasPrefix(ref, "env:"):
+		v, err = r.env(strings.TrimPrefix(ref, "env:"))
+
+	case strings.HasPrefix(ref, "keychain:"):
+		service, account, ok := strings.Cut(strings.TrimPrefix(ref, "keychain:"), "/")
+
+		if !ok || service == "" || account == "" {
+			return "", fmt.Errorf("secrets: invalid keychain reference %q, want keychain:SERVICE/ACCOUNT", ref)
+		}
+
+		v, err = r.keychain(service, account)
+
+	default:
+		v = ref
+	}
+
+	if err != nil {
+		return "", err
+	}
+
+	r.register(v)
+
+	return v, nil
+}
+
+// Lookup returns the credential named name by searching, in order, the environment variable name and the keychain
+// entry for account name under the "seesharp" service.
+func (r *Resolver) Lookup(name string) (string, error) {
+	v, err := r.env(name)
+
+	if errors.Is(err, ErrNotFound) && r.Keychain != nil {
+		v, err = r.keychain(keychainService, name)
+	}
+
+	if err != nil {
+		return "", err
+	}
+
+	r.register(v)
+
+	return v, nil
+}
+
+// Returns the value of the environment variable key.
+func (r *Resolver) env(key string) (string, error) {
+	lookupEnv := r.LookupEnv
+
+	if lookupEnv == nil {
+		lookupEnv = os.LookupEnv
+	}
+
+	if v, ok := lookupEnv(key); ok && v != "" {
+		return v, nil
+	}
+
+	return "", fmt.Errorf("%w: environment variable %s is not set", ErrNotFound, key)
+}
+
+// Returns the password stored for account under service in r's keychain.
+func (r *Resolver) keychain(service, account string) (string, error) {
+	if r.Keychain == nil {
+		return "", fmt.Errorf("secrets: keychain entry %s/%s: %w", service, account, errors.ErrUnsupported)
+	}
+
+	v, err := r.Keychain.Get(service, account)
+
+	if err == nil && v == "" {
+		err = ErrNotFound
+	}
+
+	if err != nil {
+		return "", fmt.Errorf("secrets: keychain entry %s/%s: %w", service, account, err)
+	}
+
+	return v, nil
+}
+
+// Registers v with r's Redactor, if any.
+func (r *Resolver) register(v string) {
+	if r.Redactor != nil {
+		r.Redactor.Add(v)
+	}
+}
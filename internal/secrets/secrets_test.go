@@ -0,0 +1,150 @@
+// =====================================================================================================================
+// == LICENSE:       Copyright (c) 2024 Kevin De Coninck
+// ==
+// ==                Permission is hereby granted, free of charge, to any person
+// ==                obtaining a copy of this software and associated documentation
+// ==                files (the "Software"), to deal in the Software without
+// ==                restriction, including without limitation the rights to use,
+// ==                copy, modify, merge, publish, distribute, sublicense, and/or sell
+// ==                copies of the Software, and to permit persons to whom the
+// ==                Software is furnished to do so, subject to the following
+// ==                conditions:
+// ==
+// ==                The above copyright notice and this permission notice shall be
+// ==                included in all copies or substantial portions of the Software.
+// ==
+// ==                THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// ==                EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// ==                OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// ==                NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// ==                HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// ==                WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// ==                FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+// ==                OTHER DEALINGS IN THE SOFTWARE.
+// =====================================================================================================================
+
+package secrets_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/kdeconinck/seesharp/internal/secrets"
+)
+
+// A fakeKeychain is a Keychain that stores its passwords in memory, keyed by "service/account".
+type fakeKeychain map[string]string
+
+// Get returns the password stored for account under service.
+func (k fakeKeychain) Get(service, account string) (string, error) {
+	if v, ok := k[service+"/"+account]; ok {
+		return v, nil
+	}
+
+	return "", secrets.ErrNotFound
+}
+
+// Returns a Resolver that reads env instead of the environment and keychain instead of the keychain of the operating
+// system.
+func newResolver(env map[string]string, keychain secrets.Keychain) (*secrets.Resolver, *secrets.Redactor) {
+	redactor := new(secrets.Redactor)
+
+	return &secrets.Resolver{
+		Keychain: keychain,
+		Redactor: redactor,
+		LookupEnv: func(key string) (string, bool) {
+			v, ok := env[key]
+
+			return v, ok
+		},
+	}, redactor
+}
+
+func TestResolve(t *testing.T) {
+	t.Parallel()
+
+	env := map[string]string{"SLACK_TOKEN": "xoxb-123", "EMPTY": ""}
+	keychain := fakeKeychain{"github/ci": "ghp_456"}
+
+	for _, tc := range []struct {
+		ref     string
+		want    string
+		wantErr error
+	}{
+		{ref: "env:SLACK_TOKEN", want: "xoxb-123"},
+		{ref: "env:MISSING", wantErr: secrets.ErrNotFound},
+		{ref: "env:EMPTY", wantErr: secrets.ErrNotFound},
+		{ref: "keychain:github/ci", want: "ghp_456"},
+		{ref: "keychain:github/other", wantErr: secrets.ErrNotFound},
+		{ref: "https://hooks.example.com/T000", want: "https://hooks.example.com/T000"},
+		{ref: "", want: ""},
+	} {
+		r, redactor := newResolver(env, keychain)
+		got, err := r.Resolve(tc.ref)
+
+		if got != tc.want || !errors.Is(err, tc.wantErr) {
+			t.Errorf("Resolve(%q) = %q, %v, want %q, %v", tc.ref, got, err, tc.want, tc.wantErr)
+		}
+
+		if tc.want != "" && redactor.Redact(got) != "[REDACTED]" {
+			t.Errorf("Resolve(%q) didn't register %q with the redactor", tc.ref, got)
+		}
+	}
+}
+
+func TestResolveInvalidKeychainReference(t *testing.T) {
+	t.Parallel()
+
+	r, _ := newResolver(nil, fakeKeychain{})
+
+	for _, ref := range []string{"keychain:github", "keychain:/ci", "keychain:github/"} {
+		if _, err := r.Resolve(ref); err == nil || errors.Is(err, secrets.ErrNotFound) {
+			t.Errorf("Resolve(%q) = %v, want an invalid reference error", ref, err)
+		}
+	}
+}
+
+func TestResolveWithoutKeychain(t *testing.T) {
+	t.Parallel()
+
+	r, _ := newResolver(nil, nil)
+
+	if _, err := r.Resolve("keychain:github/ci"); !errors.Is(err, errors.ErrUnsupported) {
+		t.Errorf("Resolve() = %v, want %v", err, errors.ErrUnsupported)
+	}
+}
+
+func TestLookup(t *testing.T) {
+	t.Parallel()
+
+	env := map[string]string{"SLACK_TOKEN": "from-env"}
+	keychain := fakeKeychain{"seesharp/SLACK_TOKEN": "from-keychain", "seesharp/TEAMS_URL": "https://teams"}
+
+	for _, tc := range []struct {
+		name     string
+		keychain secrets.Keychain
+		want     string
+		wantErr  error
+	}{
+		{name: "SLACK_TOKEN", keychain: keychain, want: "from-env"},
+		{name: "TEAMS_URL", keychain: keychain, want: "https://teams"},
+		{name: "TEAMS_URL", keychain: nil, wantErr: secrets.ErrNotFound},
+		{name: "MISSING", keychain: keychain, wantErr: secrets.ErrNotFound},
+	} {
+		r, _ := newResolver(env, tc.keychain)
+
+		if got, err := r.Lookup(tc.name); got != tc.want || !errors.Is(err, tc.wantErr) {
+			t.Errorf("Lookup(%q) = %q, %v, want %q, %v", tc.name, got, err, tc.want, tc.wantErr)
+		}
+	}
+}
+
+func TestResolveUsesEnvironment(t *testing.T) {
+	t.Setenv("SEESHARP_TEST_TOKEN", "secret")
+
+	r := secrets.NewResolver(nil)
+
+	if got, err := r.Resolve("env:SEESHARP_TEST_TOKEN"); got != "secret" || err != nil {
+		t.Errorf("Resolve() = %q, %v, want %q, <nil>", got, err, "secret")
+	}
+}
@@ -0,0 +1,294 @@
+// =====================================================================================================================
+// == LICENSE:       Copyright (c) 2024 Kevin De Coninck
+// ==
+// ==                Permission is hereby granted, free of charge, to any person
+// ==                obtaining a copy of this software and associated documentation
+// ==                files (the "Software"), to deal in the Software without
+// ==                restriction, including without limitation the rights to use,
+// ==                copy, modify, merge, publish, distribute, sublicense, and/or sell
+// ==                copies of the Software, and to permit persons to whom the
+// ==                Software is furnished to do so, subject to the following
+// ==                conditions:
+// ==
+// ==                The above copyright notice and this permission notice shall be
+// ==                included in all copies or substantial portions of the Software.
+// ==
+// ==                THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// ==                EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// ==                OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// ==                NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// ==                HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// ==                WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// ==                FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+// ==                OTHER DEALINGS IN THE SOFTWARE.
+// =====================================================================================================================
+
+// Package trx reads test results in the TRX format, which is produced by "dotnet test --logger trx" and Visual Studio.
+//
+// The results are grouped into one suite per test assembly, in the order in which the assemblies first appear in the
+// document. The duration of a suite is the sum of the durations of its tests.
+package trx
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/kdeconinck/seesharp/internal/results"
+)
+
+// Matches a .NET TimeSpan in the format "[d.]hh:mm:ss[.fffffff]".
+var timeSpanPattern = regexp.MustCompile(`^(?:(\d+)\.)?(\d+):(\d+):(\d+(?:\.\d+)?)$`)
+
+// Load reads a TRX document from r and converts it into a results.Run.
+func Load(r io.Reader) (results.Run, error) {
+	dec := xml.NewDecoder(r)
+
+	for {
+		tok, err := dec.Token()
+
+		if err == io.EOF {
+			return results.Run{}, fmt.Errorf("trx: no root element")
+		}
+
+		if err != nil {
+			return results.Run{}, fmt.Errorf("trx: %w", err)
+		}
+
+		start, ok := tok.(xml.StartElement)
+
+		if !ok {
+			continue
+		}
+
+		if start.Name.Local != "TestRun" {
+			return results.Run{}, fmt.Errorf("trx: unexpected root element <%s>, want <TestRun>", start.Name.Local)
+		}
+
+		var doc xmlRun
+
+		if err := dec.DecodeElement(&doc, &start); err != nil {
+			return results.Run{}, fmt.Errorf("trx: %w", err)
+		}
+
+		suites, err := convertRun(doc)
+
+		if err != nil {
+			return results.Run{}, err
+		}
+
+		return results.Run{Name: doc.Name, Suites: suites}, nil
+	}
+}
+
+// LoadFile reads the TRX document in the file name and converts it into a results.Run.
+func LoadFile(name string) (results.Run, error) {
+	f, err := os.Open(name)
+
+	if err != nil {
+		return results.Run{}, err
+	}
+
+	defer f.Close()
+
+	run, err := Load(f)
+
+	if err != nil {
+		return results.Run{}, fmt.Errorf("%s: %w", name, err)
+	}
+
+	return run, nil
+}
+
+// An xmlRun is a "TestRun" element.
+type xmlRun struct {
+	Name        string          `xml:"name,attr"`
+	Results     []xmlResult     `xml:"Results>UnitTestResult"`
+	Definitions []xmlDefinition `xml:"TestDefinitions>UnitTest"`
+}
+
+// An xmlResult is a "UnitTestResult" element.
+type xmlResult struct {
+	TestID    string `xml:"testId,attr"`
+	TestName  string `xml:"testName,attr"`
+	Outcome   string `xml:"outcome,attr"`
+	Duration  string `xml:"duration,attr"`
+	StartTime string `xml:"startTime,attr"`
+	StdOut    string `xml:"Output>StdOut"`
+	StdErr    string `xml:"Output>StdErr"`
+	Message   string `xml:"Output>ErrorInfo>Message"`
+	Trace     string `xml:"Output>ErrorInfo>StackTrace"`
+}
+
+// An xmlDefinition is a "UnitTest" element.
+type xmlDefinition struct {
+	ID      string `xml:"id,attr"`
+	Storage string `xml:"storage,attr"`
+	Method  struct {
+		CodeBase  string `xml:"codeBase,attr"`
+		ClassName string `xml:"className,attr"`
+	} `xml:"TestMethod"`
+}
+
+// Returns the suites of doc, one per test assembly.
+func convertRun(doc xmlRun) ([]results.Suite, error) {
+	definitions := make(map[string]xmlDefinition, len(doc.Definitions))
+
+	for _, def := range doc.Definitions {
+		definitions[def.ID] = def
+	}
+
+	var (
+		suites []results.Suite
+		index  = make(map[string]int) // The index in suites of the suite of each assembly.
+	)
+
+	for _, res := range doc.Results {
+		def, ok := definitions[res.TestID]
+
+		if !ok {
+			return nil, fmt.Errorf("trx: result %q refers to an unknown test %q", res.TestName, res.TestID)
+		}
+
+		test, err := convertResult(res, def)
+
+		if err != nil {
+			return nil, fmt.Errorf("trx: result %q: %w", res.TestName, err)
+		}
+
+		start, err := parseTimestamp(res.StartTime)
+
+		if err != nil {
+			return nil, fmt.Errorf("trx: result %q: %w", res.TestName, err)
+		}
+
+		assembly := assemblyName(def)
+		idx, ok := index[assembly]
+
+		if !ok {
+			idx = len(suites)
+			index[assembly] = idx
+			suites = append(suites, results.Suite{Name: assembly})
+		}
+
+		suite := &suites[idx]
+		suite.Tests = append(suite.Tests, test)
+		suite.Duration += test.Duration
+
+		if !start.IsZero() && (suite.Timestamp.IsZero() || start.Before(suite.Timestamp)) {
+			suite.Timestamp = start
+		}
+	}
+
+	return suites, nil
+}
+
+// Returns the results.Test for res, which is a result of the test defined by def.
+func convertResult(res xmlResult, def xmlDefinition) (results.Test, error) {
+	outcome, err := convertOutcome(res.Outcome)
+
+	if err != nil {
+		return results.Test{}, err
+	}
+
+	d, err := parseDuration(res.Duration)
+
+	if err != nil {
+		return results.Test{}, err
+	}
+
+	// The class name is an assembly-qualified name, such as "Tests.Calculator, Tests, Version=1.0.0.0".
+	className, _, _ := strings.Cut(def.Method.ClassName, ",")
+
+	return results.Test{
+		Name:        res.TestName,
+		ClassName:   strings.TrimSpace(className),
+		Outcome:     outcome,
+		Duration:    d,
+		Message:     strings.TrimSpace(res.Message),
+		Details:     strings.TrimSpace(res.Trace),
+		Output:      strings.TrimSpace(res.StdOut),
+		ErrorOutput: strings.TrimSpace(res.StdErr),
+	}, nil
+}
+
+// Returns the results.Outcome for the TRX outcome v.
+func convertOutcome(v string) (results.Outcome, error) {
+	switch v {
+	case "Passed", "PassedButRunAborted", "Completed", "Warning":
+		return results.Passed, nil
+	case "Failed":
+		return results.Failed, nil
+	case "NotExecuted", "NotRunnable", "Inconclusive", "Pending":
+		return results.Skipped, nil
+	case "Error", "Timeout", "Aborted", "Disconnected":
+		return results.Errored, nil
+	default:
+		return 0, fmt.Errorf("unknown outcome %q", v)
+	}
+}
+
+// Returns the file name, without directories, of the assembly that contains the test defined by def.
+func assemblyName(def xmlDefinition) string {
+	name := def.Method.CodeBase
+
+	if name == "" {
+		name = def.Storage
+	}
+
+	// TRX files written on Windows use "\" as separator, regardless of the operating system that reads them.
+	name = strings.ReplaceAll(name, `\`, "/")
+
+	return name[strings.LastIndex(name, "/")+1:]
+}
+
+// Returns the duration described by v, a .NET TimeSpan in the format "[d.]hh:mm:ss[.fffffff]". An empty v is a
+// duration of 0.
+func parseDuration(v string) (time.Duration, error) {
+	if v == "" {
+		return 0, nil
+	}
+
+	m := timeSpanPattern.FindStringSubmatch(v)
+
+	if m == nil {
+		return 0, fmt.Errorf("invalid duration %q", v)
+	}
+
+	var fields [4]float64 // Days, hours, minutes and seconds.
+
+	for idx, field := range m[1:] {
+		if field != "" {
+			fields[idx], _ = strconv.ParseFloat(field, 64)
+		}
+	}
+
+	secs := ((fields[0]*24+fields[1])*60+fields[2])*60 + fields[3]
+
+	if secs >= math.MaxInt64/float64(time.Second) {
+		return 0, fmt.Errorf("invalid duration %q", v)
+	}
+
+	return time.Duration(secs * float64(time.Second)).Round(100 * time.Nanosecond), nil
+}
+
+// Returns the time described by v, in ISO 8601 format with a time zone. An empty v is the zero time.
+func parseTimestamp(v string) (time.Time, error) {
+	if v == "" {
+		return time.Time{}, nil
+	}
+
+	ts, err := time.Parse(time.RFC3339Nano, v)
+
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid start time %q", v)
+	}
+
+	return ts, nil
+}
@@ -0,0 +1,266 @@
+// =====================================================================================================================
+// == LICENSE:       Copyright (c) 2024 Kevin De Coninck
+// ==
+// ==                Permission is hereby granted, free of charge, to any person
+// ==                obtaining a copy of this software and associated documentation
+// ==                files (the "Software"), to deal in the Software without
+// ==                restriction, including without limitation the rights to use,
+// ==                copy, modify, merge, publish, distribute, sublicense, and/or sell
+// ==                copies of the Software, and to permit persons to whom the
+// ==                Software is furnished to do so, subject to the following
+// ==                conditions:
+// ==
+// ==                The above copyright notice and this permission notice shall be
+// ==                included in all copies or substantial portions of the Software.
+// ==
+// ==                THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// ==                EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// ==                OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// ==                NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// ==                HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// ==                WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// ==                FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+// ==                OTHER DEALINGS IN THE SOFTWARE.
+// =====================================================================================================================
+
+package trx_test
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kdeconinck/seesharp/internal/results"
+	"github.com/kdeconinck/seesharp/internal/trx"
+)
+
+const trxXML = `<?xml version="1.0" encoding="utf-8"?>
+<TestRun id="1" name="agent@BUILD 2024-01-06 12:00:00" xmlns="http://microsoft.com/schemas/VisualStudio/TeamTest/2010">
+  <Results>
+    <UnitTestResult testId="t1" testName="Calc.Adds" outcome="Passed" duration="00:00:00.2500000"
+                    startTime="2024-01-06T12:00:01.0000000+00:00">
+      <Output><StdOut>adding</StdOut></Output>
+    </UnitTestResult>
+    <UnitTestResult testId="t2" testName="Calc.Divides(x: 1)" outcome="Failed" duration="00:00:01.5"
+                    startTime="2024-01-06T12:00:00.5000000+00:00">
+      <Output>
+        <StdErr>oops</StdErr>
+        <ErrorInfo>
+          <Message>Assert.Equal() Failure</Message>
+          <StackTrace>   at Calc.Divides() in Calc.cs:line 12</StackTrace>
+        </ErrorInfo>
+      </Output>
+    </UnitTestResult>
+    <UnitTestResult testId="t3" testName="Api.Gets" outcome="NotExecuted" duration="1.00:00:00">
+      <Output><ErrorInfo><Message>Not on CI</Message></ErrorInfo></Output>
+    </UnitTestResult>
+    <UnitTestResult testId="t4" testName="Calc.Times" outcome="Timeout"/>
+  </Results>
+  <TestDefinitions>
+    <UnitTest id="t1" storage="c:\src\tests\bin\calc.tests.dll">
+      <TestMethod codeBase="C:\src\tests\bin\Calc.Tests.dll" className="Calc.Tests.Calculator, Calc.Tests" name="Adds"/>
+    </UnitTest>
+    <UnitTest id="t2" storage="c:\src\tests\bin\calc.tests.dll">
+      <TestMethod codeBase="C:\src\tests\bin\Calc.Tests.dll" className="Calc.Tests.Calculator" name="Divides"/>
+    </UnitTest>
+    <UnitTest id="t3" storage="/src/api/bin/api.tests.dll">
+      <TestMethod className="Api.Tests.Client" name="Gets"/>
+    </UnitTest>
+    <UnitTest id="t4" storage="c:\src\tests\bin\calc.tests.dll">
+      <TestMethod codeBase="C:\src\tests\bin\Calc.Tests.dll" className="Calc.Tests.Calculator" name="Times"/>
+    </UnitTest>
+  </TestDefinitions>
+</TestRun>`
+
+func TestLoad(t *testing.T) {
+	t.Parallel()
+
+	run, err := trx.Load(strings.NewReader(trxXML))
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := results.Run{
+		Name: "agent@BUILD 2024-01-06 12:00:00",
+		Suites: []results.Suite{
+			{
+				Name:      "Calc.Tests.dll",
+				Timestamp: time.Date(2024, 1, 6, 12, 0, 0, 500_000_000, time.UTC),
+				Duration:  1750 * time.Millisecond,
+				Tests: []results.Test{
+					{
+						Name:      "Calc.Adds",
+						ClassName: "Calc.Tests.Calculator",
+						Outcome:   results.Passed,
+						Duration:  250 * time.Millisecond,
+						Output:    "adding",
+					},
+					{
+						Name:        "Calc.Divides(x: 1)",
+						ClassName:   "Calc.Tests.Calculator",
+						Outcome:     results.Failed,
+						Duration:    1500 * time.Millisecond,
+						Message:     "Assert.Equal() Failure",
+						Details:     "at Calc.Divides() in Calc.cs:line 12",
+						ErrorOutput: "oops",
+					},
+					{Name: "Calc.Times", ClassName: "Calc.Tests.Calculator", Outcome: results.Errored},
+				},
+			},
+			{
+				Name:     "api.tests.dll",
+				Duration: 24 * time.Hour,
+				Tests: []results.Test{
+					{
+						Name:      "Api.Gets",
+						ClassName: "Api.Tests.Client",
+						Outcome:   results.Skipped,
+						Duration:  24 * time.Hour,
+						Message:   "Not on CI",
+					},
+				},
+			},
+		},
+	}
+
+	for idx := range run.Suites {
+		// Timestamps are compared with Equal, since the parsed ones have a fixed zone rather than UTC.
+		if !run.Suites[idx].Timestamp.Equal(want.Suites[idx].Timestamp) {
+			t.Errorf("Suites[%d].Timestamp = %v, want %v", idx, run.Suites[idx].Timestamp, want.Suites[idx].Timestamp)
+		}
+
+		run.Suites[idx].Timestamp = want.Suites[idx].Timestamp
+	}
+
+	if !reflect.DeepEqual(run, want) {
+		t.Errorf("Load() = %+v, want %+v", run, want)
+	}
+}
+
+func TestLoadOutcomes(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		outcome string
+		want    results.Outcome
+	}{
+		{outcome: "Passed", want: results.Passed},
+		{outcome: "PassedButRunAborted", want: results.Passed},
+		{outcome: "Warning", want: results.Passed},
+		{outcome: "Failed", want: results.Failed},
+		{outcome: "NotExecuted", want: results.Skipped},
+		{outcome: "Inconclusive", want: results.Skipped},
+		{outcome: "Error", want: results.Errored},
+		{outcome: "Aborted", want: results.Errored},
+	} {
+		run, err := trx.Load(strings.NewReader(document(`outcome="` + tc.outcome + `"`)))
+
+		if err != nil || run.Suites[0].Tests[0].Outcome != tc.want {
+			t.Errorf("Load() with outcome %q = %+v, %v, want %v", tc.outcome, run, err, tc.want)
+		}
+	}
+}
+
+func TestLoadDurations(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		duration string
+		want     time.Duration
+	}{
+		{duration: "00:00:00", want: 0},
+		{duration: "00:00:00.0000001", want: 100 * time.Nanosecond},
+		{duration: "01:02:03.5", want: time.Hour + 2*time.Minute + 3500*time.Millisecond},
+		{duration: "2.03:00:00", want: 51 * time.Hour},
+	} {
+		run, err := trx.Load(strings.NewReader(document(`outcome="Passed" duration="` + tc.duration + `"`)))
+
+		if err != nil || run.Suites[0].Tests[0].Duration != tc.want {
+			t.Errorf("Load() with duration %q = %+v, %v, want %v", tc.duration, run, err, tc.want)
+		}
+	}
+}
+
+// Returns a TRX document with a single result that has the given attributes.
+func document(attrs string) string {
+	return `<TestRun><Results><UnitTestResult testId="t" testName="T" ` + attrs + `/></Results>
+		<TestDefinitions><UnitTest id="t" storage="tests.dll"/></TestDefinitions></TestRun>`
+}
+
+func TestLoadInvalid(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name string
+		xml  string
+		want string
+	}{
+		{name: "Empty", xml: "", want: "trx: no root element"},
+		{name: "Malformed", xml: "<TestRun>", want: "trx: XML syntax error on line 1: unexpected EOF"},
+		{name: "UnknownRoot", xml: "<testsuites/>", want: "trx: unexpected root element <testsuites>, want <TestRun>"},
+		{
+			name: "UnknownTest",
+			xml:  `<TestRun><Results><UnitTestResult testId="x" testName="T" outcome="Passed"/></Results></TestRun>`,
+			want: `trx: result "T" refers to an unknown test "x"`,
+		},
+		{name: "UnknownOutcome", xml: document(`outcome="Flaky"`), want: `trx: result "T": unknown outcome "Flaky"`},
+		{
+			name: "InvalidDuration",
+			xml:  document(`outcome="Passed" duration="1.5"`),
+			want: `trx: result "T": invalid duration "1.5"`,
+		},
+		{
+			name: "TooLargeDuration",
+			xml:  document(`outcome="Passed" duration="999999999.00:00:00"`),
+			want: `trx: result "T": invalid duration "999999999.00:00:00"`,
+		},
+		{
+			name: "InvalidStartTime",
+			xml:  document(`outcome="Passed" startTime="yesterday"`),
+			want: `trx: result "T": invalid start time "yesterday"`,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			if run, err := trx.Load(strings.NewReader(tc.xml)); err == nil || err.Error() != tc.want {
+				t.Errorf("Load(%q) = %v, want %q", tc.xml, err, tc.want)
+			} else if !reflect.DeepEqual(run, results.Run{}) {
+				t.Errorf("Load(%q) = %+v, want an empty run alongside the error", tc.xml, run)
+			}
+		})
+	}
+}
+
+func TestLoadFile(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	name := filepath.Join(dir, "results.trx")
+
+	if err := os.WriteFile(name, []byte(trxXML), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	if run, err := trx.LoadFile(name); err != nil || run.Counts().Total != 4 {
+		t.Errorf("LoadFile() = %+v, %v, want 4 tests", run, err)
+	}
+
+	if _, err := trx.LoadFile(filepath.Join(dir, "missing.trx")); !os.IsNotExist(err) {
+		t.Errorf("LoadFile() of a missing file = %v, want a not-exist error", err)
+	}
+
+	invalid := filepath.Join(dir, "invalid.trx")
+
+	if err := os.WriteFile(invalid, []byte("<testsuites/>"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := trx.LoadFile(invalid); err == nil || !strings.HasPrefix(err.Error(), invalid+": trx: ") {
+		t.Errorf("LoadFile() of an invalid file = %v, want an error prefixed with the file name", err)
+	}
+}